@@ -7,6 +7,7 @@ const (
 	ErrCodeForbidden          = "FORBIDDEN"
 	ErrCodeNotFound           = "NOT_FOUND"
 	ErrCodeConflict           = "CONFLICT"
+	ErrCodeTooManyRequests    = "TOO_MANY_REQUESTS"
 	ErrCodeInternal           = "INTERNAL_ERROR"
 )
 
@@ -0,0 +1,147 @@
+package handler
+
+import (
+	apperrors "collabotask/internal/adapter/http/errors"
+	"collabotask/internal/adapter/http/helper"
+	"collabotask/internal/adapter/http/request"
+	"collabotask/internal/adapter/http/response"
+	"collabotask/internal/domain"
+	"collabotask/internal/usecase/accesstoken"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type AccessTokenHandler struct {
+	accessTokenUseCase accesstoken.AccessTokenUseCase
+}
+
+func NewAccessTokenHandler(accessTokenUseCase accesstoken.AccessTokenUseCase) *AccessTokenHandler {
+	return &AccessTokenHandler{accessTokenUseCase: accessTokenUseCase}
+}
+
+func handleAccessTokenError(ctx *gin.Context, err error) {
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		response.HandleValidationError(ctx, err)
+		return
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrAccessTokenNotFound):
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusNotFound, apperrors.ErrCodeNotFound, err.Error()))
+	default:
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusInternalServerError, apperrors.ErrCodeInternal, err.Error()))
+	}
+}
+
+// CreateAccessToken godoc
+// @Summary Create a personal access token
+// @Description Creates a token for scripts and CI. The token value is only returned in this response. Requires a regular login session.
+// @Tags user
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body request.CreateAccessTokenRequest true "Token name, scope and optional lifetime"
+// @Success 201 {object} response.AccessTokenCreateSuccessDoc "Created"
+// @Failure 400 {object} response.Failure400ValidationDoc "Validation error"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Unauthorized"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "Called with a personal access token"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /user/tokens [post]
+func (h *AccessTokenHandler) CreateAccessToken(ctx *gin.Context) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return
+	}
+
+	var req request.CreateAccessTokenRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		response.HandleValidationError(ctx, err)
+		return
+	}
+
+	out, err := h.accessTokenUseCase.CreateToken(ctx.Request.Context(), accesstoken.CreateTokenInput{
+		UserID:        userID,
+		Name:          req.Name,
+		Scope:         req.Scope,
+		ExpiresInDays: req.ExpiresInDays,
+	})
+	if err != nil {
+		handleAccessTokenError(ctx, err)
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Access token created successfully", response.CreatedAccessTokenResponse{
+		AccessTokenResponse: response.AccessTokenDTOToResponse(out.AccessToken),
+		Token:               out.Token,
+	}, http.StatusCreated)
+}
+
+// ListAccessTokens godoc
+// @Summary List personal access tokens
+// @Description Lists the caller's active tokens. Only the token prefix is shown.
+// @Tags user
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.AccessTokenListSuccessDoc "OK"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Unauthorized"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /user/tokens [get]
+func (h *AccessTokenHandler) ListAccessTokens(ctx *gin.Context) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return
+	}
+
+	out, err := h.accessTokenUseCase.ListTokens(ctx.Request.Context(), accesstoken.ListTokensInput{UserID: userID})
+	if err != nil {
+		handleAccessTokenError(ctx, err)
+		return
+	}
+
+	tokens := make([]response.AccessTokenResponse, 0, len(out.AccessTokens))
+	for _, token := range out.AccessTokens {
+		tokens = append(tokens, response.AccessTokenDTOToResponse(token))
+	}
+
+	response.GenerateSuccessResponse(ctx, "Access tokens retrieved successfully", tokens)
+}
+
+// RevokeAccessToken godoc
+// @Summary Revoke a personal access token
+// @Tags user
+// @Produce json
+// @Security BearerAuth
+// @Param token_id path string true "Access token UUID"
+// @Success 200 {object} response.AccessTokenRevokeSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400BadRequestDoc "Invalid token id"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Unauthorized"
+// @Failure 404 {object} response.Failure404NotFoundDoc "Access token not found"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /user/tokens/{token_id} [delete]
+func (h *AccessTokenHandler) RevokeAccessToken(ctx *gin.Context) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return
+	}
+
+	tokenID, ok := helper.ParseUUIDParams(ctx, "token_id")
+	if !ok {
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, "Invalid token id"))
+		return
+	}
+
+	err := h.accessTokenUseCase.RevokeToken(ctx.Request.Context(), accesstoken.RevokeTokenInput{
+		UserID:  userID,
+		TokenID: tokenID,
+	})
+	if err != nil {
+		handleAccessTokenError(ctx, err)
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Access token revoked successfully", nil)
+}
@@ -0,0 +1,221 @@
+package handler
+
+import (
+	apperrors "collabotask/internal/adapter/http/errors"
+	"collabotask/internal/adapter/http/helper"
+	"collabotask/internal/adapter/http/request"
+	"collabotask/internal/adapter/http/response"
+	"collabotask/internal/domain"
+	"collabotask/internal/usecase/admin"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type AdminHandler struct {
+	adminUseCase admin.AdminUseCase
+}
+
+func NewAdminHandler(adminUseCase admin.AdminUseCase) *AdminHandler {
+	return &AdminHandler{adminUseCase: adminUseCase}
+}
+
+func handleAdminError(ctx *gin.Context, err error) {
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		response.HandleValidationError(ctx, err)
+		return
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrUserNotFound):
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusNotFound, apperrors.ErrCodeNotFound, err.Error()))
+	case errors.Is(err, domain.ErrCannotSuspendYourself):
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, err.Error()))
+	default:
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusInternalServerError, apperrors.ErrCodeInternal, err.Error()))
+	}
+}
+
+// ListUsers godoc
+// @Summary List users on the instance
+// @Description Super-admin only. Deleted accounts are excluded; search matches email prefix or name.
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param search query string false "Email prefix or name fragment"
+// @Param limit query int false "Page size (1-100, default 20)"
+// @Param offset query int false "Number of users to skip"
+// @Success 200 {object} response.AdminUserListSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400ValidationDoc "Validation error"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Unauthorized"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "Not a super admin"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /admin/users [get]
+func (h *AdminHandler) ListUsers(ctx *gin.Context) {
+	var req request.AdminListUsersRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		response.HandleValidationError(ctx, err)
+		return
+	}
+
+	out, err := h.adminUseCase.ListUsers(ctx.Request.Context(), admin.ListUsersInput{
+		Search: req.Search,
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	})
+	if err != nil {
+		handleAdminError(ctx, err)
+		return
+	}
+
+	users := make([]response.AdminUserResponse, 0, len(out.Users))
+	for _, user := range out.Users {
+		users = append(users, response.AdminUserDTOToResponse(user))
+	}
+
+	response.GenerateSuccessResponse(ctx, "Users retrieved successfully", response.AdminUserListResponse{
+		Users:  users,
+		Total:  out.Total,
+		Limit:  out.Limit,
+		Offset: out.Offset,
+	})
+}
+
+// SuspendUser godoc
+// @Summary Suspend a user
+// @Description Super-admin only. Blocks login and signs the user out of every session.
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param user_id path string true "User UUID"
+// @Success 200 {object} response.AdminUserSuspendSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400BadRequestDoc "Invalid user id or suspending yourself"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Unauthorized"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "Not a super admin"
+// @Failure 404 {object} response.Failure404NotFoundDoc "User not found"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /admin/users/{user_id}/suspend [post]
+func (h *AdminHandler) SuspendUser(ctx *gin.Context) {
+	h.setUserSuspended(ctx, true, "User suspended successfully")
+}
+
+// UnsuspendUser godoc
+// @Summary Lift a user's suspension
+// @Description Super-admin only.
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param user_id path string true "User UUID"
+// @Success 200 {object} response.AdminUserSuspendSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400BadRequestDoc "Invalid user id"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Unauthorized"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "Not a super admin"
+// @Failure 404 {object} response.Failure404NotFoundDoc "User not found"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /admin/users/{user_id}/unsuspend [post]
+func (h *AdminHandler) UnsuspendUser(ctx *gin.Context) {
+	h.setUserSuspended(ctx, false, "User unsuspended successfully")
+}
+
+func (h *AdminHandler) setUserSuspended(ctx *gin.Context, suspended bool, message string) {
+	requesterID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return
+	}
+
+	userID, ok := helper.ParseUUIDParams(ctx, "user_id")
+	if !ok {
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, "Invalid user id"))
+		return
+	}
+
+	user, err := h.adminUseCase.SetUserSuspended(ctx.Request.Context(), admin.SetUserSuspendedInput{
+		RequesterID: requesterID,
+		UserID:      userID,
+		Suspended:   suspended,
+	})
+	if err != nil {
+		handleAdminError(ctx, err)
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, message, response.AdminUserDTOToResponse(*user))
+}
+
+// ForcePasswordReset godoc
+// @Summary Force a user to reset their password
+// @Description Super-admin only. Blocks login until the password is reset, signs the user out everywhere, revokes their personal access tokens and emails a reset link.
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param user_id path string true "User UUID"
+// @Success 200 {object} response.AdminForcePasswordResetSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400BadRequestDoc "Invalid user id"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Unauthorized"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "Not a super admin"
+// @Failure 404 {object} response.Failure404NotFoundDoc "User not found"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /admin/users/{user_id}/force-password-reset [post]
+func (h *AdminHandler) ForcePasswordReset(ctx *gin.Context) {
+	userID, ok := helper.ParseUUIDParams(ctx, "user_id")
+	if !ok {
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, "Invalid user id"))
+		return
+	}
+
+	err := h.adminUseCase.ForcePasswordReset(ctx.Request.Context(), admin.ForcePasswordResetInput{UserID: userID})
+	if err != nil {
+		handleAdminError(ctx, err)
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Password reset enforced successfully", nil)
+}
+
+// ListWorkspaces godoc
+// @Summary List every workspace on the instance
+// @Description Super-admin only. Search matches workspace names.
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param search query string false "Name fragment"
+// @Param limit query int false "Page size (1-100, default 20)"
+// @Param offset query int false "Number of workspaces to skip"
+// @Success 200 {object} response.AdminWorkspaceListSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400ValidationDoc "Validation error"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Unauthorized"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "Not a super admin"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /admin/workspaces [get]
+func (h *AdminHandler) ListWorkspaces(ctx *gin.Context) {
+	var req request.AdminListWorkspacesRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		response.HandleValidationError(ctx, err)
+		return
+	}
+
+	out, err := h.adminUseCase.ListWorkspaces(ctx.Request.Context(), admin.ListWorkspacesInput{
+		Search: req.Search,
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	})
+	if err != nil {
+		handleAdminError(ctx, err)
+		return
+	}
+
+	workspaces := make([]response.WorkspaceSummaryResponse, 0, len(out.Workspaces))
+	for _, workspace := range out.Workspaces {
+		workspaces = append(workspaces, response.WorkspaceSummaryDTOToResponse(workspace))
+	}
+
+	response.GenerateSuccessResponse(ctx, "Workspaces retrieved successfully", response.AdminWorkspaceListResponse{
+		Workspaces: workspaces,
+		Total:      out.Total,
+		Limit:      out.Limit,
+		Offset:     out.Offset,
+	})
+}
@@ -2,16 +2,28 @@ package handler
 
 import (
 	apperrors "collabotask/internal/adapter/http/errors"
+	"collabotask/internal/adapter/http/helper"
+	"collabotask/internal/adapter/http/middleware"
 	"collabotask/internal/adapter/http/request"
 	"collabotask/internal/adapter/http/response"
 	"collabotask/internal/domain"
 	"collabotask/internal/usecase/auth"
 	"errors"
+	"math"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
 
+// oidcStateCookie binds a single sign-on callback to the browser that
+// started the login, so a stolen callback URL cannot be replayed elsewhere.
+const (
+	oidcStateCookie     = "collabotask_oidc_state"
+	oidcStateCookiePath = "/api/v1/auth/oidc"
+)
+
 type AuthHandler struct {
 	authUseCase auth.AuthUseCase
 }
@@ -22,12 +34,13 @@ func NewAuthHandler(authUseCase auth.AuthUseCase) *AuthHandler {
 
 // Register godoc
 // @Summary Register a new user
+// @Description An optional invitation_token from a workspace invitation email verifies the address and joins every workspace it was invited to.
 // @Tags auth
 // @Accept json
 // @Produce json
 // @Param body body request.RegisterRequest true "Registration payload"
 // @Success 201 {object} response.AuthRegisterSuccessDoc "Created"
-// @Failure 400 {object} response.Failure400BadRequestDoc "Validation error"
+// @Failure 400 {object} response.Failure400BadRequestDoc "Validation error or invalid invitation token"
 // @Failure 409 {object} response.Failure409ConflictDoc "Conflict"
 // @Router /auth/register [post]
 func (ah *AuthHandler) Register(ctx *gin.Context) {
@@ -38,35 +51,46 @@ func (ah *AuthHandler) Register(ctx *gin.Context) {
 	}
 
 	out, err := ah.authUseCase.Register(ctx.Request.Context(), auth.RegisterInput{
-		Email:    req.Email,
-		Name:     req.Name,
-		Password: req.Password,
+		Email:           req.Email,
+		Name:            req.Name,
+		Password:        req.Password,
+		InvitationToken: req.InvitationToken,
+		Client:          clientInfo(ctx),
 	})
 	if err != nil {
 		if errors.Is(err, domain.ErrEmailAlreadyExists) {
 			response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusConflict, apperrors.ErrCodeConflict, err.Error()))
 			return
 		}
+		if errors.Is(err, domain.ErrInvalidInvitation) || errors.Is(err, domain.ErrInvitationEmailMismatch) {
+			response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, err.Error()))
+			return
+		}
 
 		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusConflict, apperrors.ErrCodeValidation, err.Error()))
 		return
 	}
 
 	response.GenerateSuccessResponse(ctx, "User registered successfully", response.AuthResponse{
-		User:  response.UserDTOToResponse(out.User),
-		Token: out.Token,
+		User:         response.UserDTOToResponse(out.User),
+		Token:        out.Token,
+		RefreshToken: out.RefreshToken,
 	}, http.StatusCreated)
 }
 
 // Login godoc
 // @Summary Log in a user
+// @Description When the account has two-factor authentication enabled no tokens are returned; instead only mfa_required and mfa_token are returned, and the token must be exchanged at /auth/mfa/verify.
 // @Tags auth
 // @Accept json
 // @Produce json
 // @Param body body request.LoginRequest true "Login credentials"
 // @Success 200 {object} response.AuthLoginSuccessDoc "OK"
+// @Success 200 {object} response.MFAChallengeSuccessDoc "Two-factor authentication required"
 // @Failure 400 {object} response.Failure400ValidationDoc "Validation error"
 // @Failure 401 {object} response.Failure401LoginDoc "Unauthorized"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "Email not verified, account suspended or password reset required"
+// @Failure 429 {object} response.Failure429TooManyRequestsDoc "Too many failed attempts; see the Retry-After header"
 // @Router /auth/login [post]
 func (ah *AuthHandler) Login(ctx *gin.Context) {
 	var req request.LoginRequest
@@ -78,14 +102,430 @@ func (ah *AuthHandler) Login(ctx *gin.Context) {
 	out, err := ah.authUseCase.Login(ctx.Request.Context(), auth.LoginInput{
 		Email:    req.Email,
 		Password: req.Password,
+		Client:   clientInfo(ctx),
 	})
 	if err != nil {
+		var throttled *domain.LoginThrottledError
+		if errors.As(err, &throttled) {
+			ctx.Header("Retry-After", strconv.Itoa(int(math.Ceil(throttled.RetryAfter.Seconds()))))
+			response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusTooManyRequests, apperrors.ErrCodeTooManyRequests, err.Error()))
+			return
+		}
+
+		if errors.Is(err, domain.ErrEmailNotVerified) ||
+			errors.Is(err, domain.ErrAccountSuspended) ||
+			errors.Is(err, domain.ErrPasswordResetRequired) {
+			response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusForbidden, apperrors.ErrCodeForbidden, err.Error()))
+			return
+		}
+
 		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusUnauthorized, apperrors.ErrCodeUnauthorized, err.Error()))
 		return
 	}
 
+	if out.MFARequired {
+		response.GenerateSuccessResponse(ctx, "Two-factor authentication required", response.MFAChallengeResponse{
+			MFARequired: true,
+			MFAToken:    out.MFAToken,
+		})
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Successfully logged in", response.AuthResponse{
+		User:         response.UserDTOToResponse(out.User),
+		Token:        out.Token,
+		RefreshToken: out.RefreshToken,
+	})
+}
+
+// VerifyMFA godoc
+// @Summary Complete a two-factor login
+// @Description Exchanges the mfa_token returned by /auth/login and a TOTP or recovery code for the normal token pair. A challenge expires after a few minutes or after too many wrong codes.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param body body request.VerifyMFARequest true "Challenge token and code"
+// @Success 200 {object} response.AuthLoginSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400ValidationDoc "Validation error"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Invalid code or invalid/expired challenge"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "Account suspended or password reset required"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /auth/mfa/verify [post]
+func (ah *AuthHandler) VerifyMFA(ctx *gin.Context) {
+	var req request.VerifyMFARequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		response.HandleValidationError(ctx, err)
+		return
+	}
+
+	out, err := ah.authUseCase.VerifyMFA(ctx.Request.Context(), auth.VerifyMFAInput{
+		MFAToken: req.MFAToken,
+		Code:     req.Code,
+		Client:   clientInfo(ctx),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidMFACode), errors.Is(err, domain.ErrInvalidMFAChallenge):
+			response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusUnauthorized, apperrors.ErrCodeUnauthorized, err.Error()))
+		case errors.Is(err, domain.ErrAccountSuspended), errors.Is(err, domain.ErrPasswordResetRequired):
+			response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusForbidden, apperrors.ErrCodeForbidden, err.Error()))
+		default:
+			response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusInternalServerError, apperrors.ErrCodeInternal, err.Error()))
+		}
+		return
+	}
+
 	response.GenerateSuccessResponse(ctx, "Successfully logged in", response.AuthResponse{
-		User:  response.UserDTOToResponse(out.User),
-		Token: out.Token,
+		User:         response.UserDTOToResponse(out.User),
+		Token:        out.Token,
+		RefreshToken: out.RefreshToken,
+	})
+}
+
+// StartOIDCLogin godoc
+// @Summary Start a single sign-on login
+// @Description Redirects the browser to the OpenID Connect provider using the authorization-code flow with PKCE.
+// @Tags auth
+// @Success 302 "Redirect to the identity provider"
+// @Failure 404 {object} response.Failure404NotFoundDoc "Single sign-on is not configured"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /auth/oidc/login [get]
+func (ah *AuthHandler) StartOIDCLogin(ctx *gin.Context) {
+	out, err := ah.authUseCase.StartOIDCLogin(ctx.Request.Context())
+	if err != nil {
+		handleOIDCError(ctx, err)
+		return
+	}
+
+	ctx.SetSameSite(http.SameSiteLaxMode)
+	ctx.SetCookie(oidcStateCookie, out.State, int(time.Until(out.ExpiresAt).Seconds()), oidcStateCookiePath, "", isSecureRequest(ctx), true)
+	ctx.Redirect(http.StatusFound, out.AuthURL)
+}
+
+// CompleteOIDCLogin godoc
+// @Summary Finish a single sign-on login
+// @Description Callback for the OpenID Connect provider. Links the identity to the account with the same verified email (an account whose email was never verified is refused until it is), or provisions a new account, and returns the usual token pair. Accounts with two-factor authentication enabled get an mfa_token instead, as with /auth/login.
+// @Tags auth
+// @Produce json
+// @Param code query string true "Authorization code"
+// @Param state query string true "State issued by /auth/oidc/login"
+// @Success 200 {object} response.AuthLoginSuccessDoc "OK"
+// @Success 200 {object} response.MFAChallengeSuccessDoc "Two-factor authentication required"
+// @Failure 400 {object} response.Failure400BadRequestDoc "Missing, invalid or expired state"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "The identity provider rejected the login"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "Email not verified by the provider, matching account not verified, no matching account or account suspended"
+// @Failure 404 {object} response.Failure404NotFoundDoc "Single sign-on is not configured"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /auth/oidc/callback [get]
+func (ah *AuthHandler) CompleteOIDCLogin(ctx *gin.Context) {
+	if providerErr := ctx.Query("error"); providerErr != "" {
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusUnauthorized, apperrors.ErrCodeUnauthorized, "Identity provider returned "+providerErr))
+		return
+	}
+
+	state := ctx.Query("state")
+	cookieState, err := ctx.Cookie(oidcStateCookie)
+	if err != nil || cookieState == "" || cookieState != state {
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, domain.ErrInvalidOIDCState.Error()))
+		return
+	}
+	ctx.SetSameSite(http.SameSiteLaxMode)
+	ctx.SetCookie(oidcStateCookie, "", -1, oidcStateCookiePath, "", isSecureRequest(ctx), true)
+
+	out, err := ah.authUseCase.CompleteOIDCLogin(ctx.Request.Context(), auth.CompleteOIDCLoginInput{
+		State:  state,
+		Code:   ctx.Query("code"),
+		Client: clientInfo(ctx),
+	})
+	if err != nil {
+		handleOIDCError(ctx, err)
+		return
+	}
+
+	if out.MFARequired {
+		response.GenerateSuccessResponse(ctx, "Two-factor authentication required", response.MFAChallengeResponse{
+			MFARequired: true,
+			MFAToken:    out.MFAToken,
+		})
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Successfully logged in", response.AuthResponse{
+		User:         response.UserDTOToResponse(out.User),
+		Token:        out.Token,
+		RefreshToken: out.RefreshToken,
+	})
+}
+
+// RefreshToken godoc
+// @Summary Exchange a refresh token for a new token pair
+// @Description Rotates the refresh token on every use. Replaying an already used refresh token revokes its whole family.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param body body request.RefreshTokenRequest true "Refresh token"
+// @Success 200 {object} response.AuthRefreshSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400ValidationDoc "Validation error"
+// @Failure 401 {object} response.Failure401RefreshDoc "Invalid, expired or reused refresh token"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /auth/refresh [post]
+func (ah *AuthHandler) RefreshToken(ctx *gin.Context) {
+	var req request.RefreshTokenRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		response.HandleValidationError(ctx, err)
+		return
+	}
+
+	out, err := ah.authUseCase.RefreshToken(ctx.Request.Context(), auth.RefreshTokenInput{
+		RefreshToken: req.RefreshToken,
+		Client:       clientInfo(ctx),
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidRefreshToken) || errors.Is(err, domain.ErrRefreshTokenReused) {
+			response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusUnauthorized, apperrors.ErrCodeUnauthorized, err.Error()))
+			return
+		}
+
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusInternalServerError, apperrors.ErrCodeInternal, err.Error()))
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Token refreshed successfully", response.TokenResponse{
+		Token:        out.Token,
+		RefreshToken: out.RefreshToken,
+	})
+}
+
+// Logout godoc
+// @Summary Log out the current session
+// @Description Revokes the access token used for this request. When a refresh token is given its whole family is revoked too.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body request.LogoutRequest false "Refresh token to revoke"
+// @Success 200 {object} response.AuthLogoutSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400ValidationDoc "Validation error"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Missing or invalid Bearer token"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /auth/logout [post]
+func (ah *AuthHandler) Logout(ctx *gin.Context) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return
+	}
+
+	claims, ok := middleware.GetTokenClaims(ctx)
+	if !ok {
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusUnauthorized, apperrors.ErrCodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	var req request.LogoutRequest
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			response.HandleValidationError(ctx, err)
+			return
+		}
+	}
+
+	input := auth.LogoutInput{
+		UserID:       userID,
+		TokenID:      claims.ID,
+		SessionID:    claims.SessionID,
+		RefreshToken: req.RefreshToken,
+	}
+	if claims.ExpiresAt != nil {
+		input.ExpiresAt = claims.ExpiresAt.Time
+	}
+
+	if err := ah.authUseCase.Logout(ctx.Request.Context(), input); err != nil {
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusInternalServerError, apperrors.ErrCodeInternal, err.Error()))
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Successfully logged out", nil)
+}
+
+// LogoutAll godoc
+// @Summary Log out from every session
+// @Description Invalidates every access and refresh token issued to the authenticated user so far.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.AuthLogoutAllSuccessDoc "OK"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Missing or invalid Bearer token"
+// @Failure 404 {object} response.Failure404NotFoundDoc "User not found"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /auth/logout-all [post]
+func (ah *AuthHandler) LogoutAll(ctx *gin.Context) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return
+	}
+
+	if err := ah.authUseCase.LogoutAll(ctx.Request.Context(), auth.LogoutAllInput{UserID: userID}); err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusNotFound, apperrors.ErrCodeNotFound, err.Error()))
+			return
+		}
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusInternalServerError, apperrors.ErrCodeInternal, err.Error()))
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Successfully logged out from all sessions", nil)
+}
+
+// ForgotPassword godoc
+// @Summary Request a password reset email
+// @Description Always responds with success so the endpoint cannot be used to discover registered emails.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param body body request.ForgotPasswordRequest true "Account email"
+// @Success 200 {object} response.AuthForgotPasswordSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400ValidationDoc "Validation error"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /auth/password/forgot [post]
+func (ah *AuthHandler) ForgotPassword(ctx *gin.Context) {
+	var req request.ForgotPasswordRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		response.HandleValidationError(ctx, err)
+		return
+	}
+
+	err := ah.authUseCase.ForgotPassword(ctx.Request.Context(), auth.ForgotPasswordInput{
+		Email: req.Email,
 	})
+	if err != nil {
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusInternalServerError, apperrors.ErrCodeInternal, err.Error()))
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "If the email is registered, a password reset link has been sent", nil)
+}
+
+// ResetPassword godoc
+// @Summary Reset password with a reset token
+// @Description Consumes a single-use reset token, sets the new password and signs out every existing session.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param body body request.ResetPasswordRequest true "Reset token and new password"
+// @Success 200 {object} response.AuthResetPasswordSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400ValidationDoc "Validation error or invalid/expired token"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /auth/password/reset [post]
+func (ah *AuthHandler) ResetPassword(ctx *gin.Context) {
+	var req request.ResetPasswordRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		response.HandleValidationError(ctx, err)
+		return
+	}
+
+	err := ah.authUseCase.ResetPassword(ctx.Request.Context(), auth.ResetPasswordInput{
+		Token:       req.Token,
+		NewPassword: req.NewPassword,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidPasswordResetToken) {
+			response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, err.Error()))
+			return
+		}
+
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusInternalServerError, apperrors.ErrCodeInternal, err.Error()))
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Password has been reset successfully", nil)
+}
+
+// VerifyEmail godoc
+// @Summary Verify an email address
+// @Description Consumes the single-use token sent by email after registration.
+// @Tags auth
+// @Produce json
+// @Param token query string true "Verification token"
+// @Success 200 {object} response.AuthVerifyEmailSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400BadRequestDoc "Missing, invalid or expired token"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /auth/verify [get]
+func (ah *AuthHandler) VerifyEmail(ctx *gin.Context) {
+	token := ctx.Query("token")
+	if token == "" {
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, "Missing verification token"))
+		return
+	}
+
+	err := ah.authUseCase.VerifyEmail(ctx.Request.Context(), auth.VerifyEmailInput{Token: token})
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidEmailVerificationToken) {
+			response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, err.Error()))
+			return
+		}
+
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusInternalServerError, apperrors.ErrCodeInternal, err.Error()))
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Email verified successfully", nil)
+}
+
+// ResendVerificationEmail godoc
+// @Summary Resend the email verification link
+// @Description Always responds with success so the endpoint cannot be used to discover registered emails.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param body body request.ResendVerificationEmailRequest true "Account email"
+// @Success 200 {object} response.AuthResendVerificationSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400ValidationDoc "Validation error"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /auth/verify/resend [post]
+func (ah *AuthHandler) ResendVerificationEmail(ctx *gin.Context) {
+	var req request.ResendVerificationEmailRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		response.HandleValidationError(ctx, err)
+		return
+	}
+
+	err := ah.authUseCase.ResendVerificationEmail(ctx.Request.Context(), auth.ResendVerificationEmailInput{
+		Email: req.Email,
+	})
+	if err != nil {
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusInternalServerError, apperrors.ErrCodeInternal, err.Error()))
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "If the email is registered and unverified, a verification link has been sent", nil)
+}
+
+func handleOIDCError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, domain.ErrOIDCNotConfigured):
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusNotFound, apperrors.ErrCodeNotFound, err.Error()))
+	case errors.Is(err, domain.ErrInvalidOIDCState):
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, err.Error()))
+	case errors.Is(err, domain.ErrOIDCLoginFailed):
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusUnauthorized, apperrors.ErrCodeUnauthorized, domain.ErrOIDCLoginFailed.Error()))
+	case errors.Is(err, domain.ErrOIDCEmailNotVerified),
+		errors.Is(err, domain.ErrOIDCAccountNotVerified),
+		errors.Is(err, domain.ErrOIDCProvisioningBlocked),
+		errors.Is(err, domain.ErrAccountSuspended):
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusForbidden, apperrors.ErrCodeForbidden, err.Error()))
+	default:
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusInternalServerError, apperrors.ErrCodeInternal, err.Error()))
+	}
+}
+
+func isSecureRequest(ctx *gin.Context) bool {
+	return ctx.Request.TLS != nil || ctx.GetHeader("X-Forwarded-Proto") == "https"
+}
+
+func clientInfo(ctx *gin.Context) auth.ClientInfo {
+	return auth.ClientInfo{
+		IPAddress: ctx.ClientIP(),
+		UserAgent: ctx.Request.UserAgent(),
+	}
 }
@@ -0,0 +1,178 @@
+package handler
+
+import (
+	apperrors "collabotask/internal/adapter/http/errors"
+	"collabotask/internal/adapter/http/helper"
+	"collabotask/internal/adapter/http/response"
+	"collabotask/internal/domain"
+	"collabotask/internal/usecase/avatar"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+// multipartOverhead leaves room for boundaries and part headers on top of the
+// avatar itself when capping the request body.
+const multipartOverhead = 64 << 10
+
+type AvatarHandler struct {
+	avatarUseCase  avatar.AvatarUseCase
+	maxUploadBytes int64
+}
+
+func NewAvatarHandler(avatarUseCase avatar.AvatarUseCase, maxUploadBytes int64) *AvatarHandler {
+	return &AvatarHandler{avatarUseCase: avatarUseCase, maxUploadBytes: maxUploadBytes}
+}
+
+func handleAvatarError(ctx *gin.Context, err error) {
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		response.HandleValidationError(ctx, err)
+		return
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrAvatarTooLarge), errors.Is(err, domain.ErrAvatarDimensionsTooLarge):
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusRequestEntityTooLarge, apperrors.ErrCodeValidation, err.Error()))
+	case errors.Is(err, domain.ErrUnsupportedAvatarType):
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusUnsupportedMediaType, apperrors.ErrCodeValidation, err.Error()))
+	case errors.Is(err, domain.ErrAvatarNotFound), errors.Is(err, domain.ErrUserNotFound):
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusNotFound, apperrors.ErrCodeNotFound, err.Error()))
+	default:
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusInternalServerError, apperrors.ErrCodeInternal, err.Error()))
+	}
+}
+
+// UploadAvatar godoc
+// @Summary Upload avatar
+// @Description Replaces the caller's avatar with an uploaded PNG, JPEG or GIF. The file type is detected from its content, the centre square is kept and 256px and 64px thumbnails are stored. avatar_url then points at GET /users/{user_id}/avatar.
+// @Tags user
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param avatar formData file true "Image file"
+// @Success 200 {object} response.UserUpdateProfileSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400BadRequestDoc "Missing avatar file"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Unauthorized"
+// @Failure 413 {object} response.Failure413PayloadTooLargeDoc "File or image dimensions too large"
+// @Failure 415 {object} response.Failure415UnsupportedMediaTypeDoc "Not a PNG, JPEG or GIF image"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /user/avatar [post]
+func (h *AvatarHandler) UploadAvatar(ctx *gin.Context) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return
+	}
+
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, h.maxUploadBytes+multipartOverhead)
+	fileHeader, err := ctx.FormFile("avatar")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			handleAvatarError(ctx, domain.ErrAvatarTooLarge)
+			return
+		}
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, "avatar file is required"))
+		return
+	}
+	if fileHeader.Size > h.maxUploadBytes {
+		handleAvatarError(ctx, domain.ErrAvatarTooLarge)
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusInternalServerError, apperrors.ErrCodeInternal, err.Error()))
+		return
+	}
+	defer file.Close()
+
+	user, err := h.avatarUseCase.UploadAvatar(ctx.Request.Context(), avatar.UploadAvatarInput{
+		UserID:  userID,
+		Content: file,
+	})
+	if err != nil {
+		handleAvatarError(ctx, err)
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Avatar uploaded successfully", response.UserDTOToResponse(*user))
+}
+
+// DeleteAvatar godoc
+// @Summary Remove avatar
+// @Description Clears the caller's avatar and deletes any uploaded avatar images.
+// @Tags user
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.UserUpdateProfileSuccessDoc "OK"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Unauthorized"
+// @Failure 404 {object} response.Failure404NotFoundDoc "User not found"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /user/avatar [delete]
+func (h *AvatarHandler) DeleteAvatar(ctx *gin.Context) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return
+	}
+
+	user, err := h.avatarUseCase.DeleteAvatar(ctx.Request.Context(), avatar.DeleteAvatarInput{UserID: userID})
+	if err != nil {
+		handleAvatarError(ctx, err)
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Avatar removed successfully", response.UserDTOToResponse(*user))
+}
+
+// GetAvatar godoc
+// @Summary Get a user's avatar
+// @Description Serves an uploaded avatar image without authentication so it can be used in img tags. Redirects to a short-lived signed URL when the storage backend supports them, otherwise streams the PNG.
+// @Tags user
+// @Produce png
+// @Param user_id path string true "User UUID"
+// @Param size query int false "Edge length in pixels (64 or 256, default 256)"
+// @Success 200 {file} file "Avatar image"
+// @Success 302 "Redirect to a signed storage URL"
+// @Failure 400 {object} response.Failure400ValidationDoc "Invalid user id or size"
+// @Failure 404 {object} response.Failure404NotFoundDoc "Avatar not found"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /users/{user_id}/avatar [get]
+func (h *AvatarHandler) GetAvatar(ctx *gin.Context) {
+	userID, ok := helper.ParseUUIDParams(ctx, "user_id")
+	if !ok {
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, "Invalid user id"))
+		return
+	}
+
+	input := avatar.GetAvatarInput{UserID: userID}
+	if raw := ctx.Query("size"); raw != "" {
+		size, err := strconv.Atoi(raw)
+		if err != nil {
+			response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, "Invalid size"))
+			return
+		}
+		input.Size = size
+	}
+
+	out, err := h.avatarUseCase.GetAvatar(ctx.Request.Context(), input)
+	if err != nil {
+		handleAvatarError(ctx, err)
+		return
+	}
+
+	if out.RedirectURL != "" {
+		ctx.Header("Cache-Control", "private, no-store")
+		ctx.Redirect(http.StatusFound, out.RedirectURL)
+		return
+	}
+
+	defer out.Object.Body.Close()
+	// avatar_url carries a version parameter, so each URL's content is fixed.
+	ctx.Header("Cache-Control", "public, max-age=86400")
+	ctx.Header("X-Content-Type-Options", "nosniff")
+	ctx.DataFromReader(http.StatusOK, out.Object.Size, out.Object.ContentType, out.Object.Body, nil)
+}
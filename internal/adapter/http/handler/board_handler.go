@@ -30,12 +30,15 @@ func handleBoardError(ctx *gin.Context, err error) {
 	case errors.Is(err, domain.ErrUserNotInWorkspace),
 		errors.Is(err, domain.ErrBoardAccessDenied),
 		errors.Is(err, domain.ErrBoardPermissionDenied),
+		errors.Is(err, domain.ErrWorkspaceGuest),
 		errors.Is(err, domain.ErrBoardOwnerCannotLeave):
 		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusForbidden, apperrors.ErrCodeForbidden, err.Error()))
 	case errors.Is(err, domain.ErrBoardNotFound),
 		errors.Is(err, domain.ErrBoardMemberNotFound),
 		errors.Is(err, domain.ErrUserNotFound),
-		errors.Is(err, domain.ErrMemberNotFound):
+		errors.Is(err, domain.ErrMemberNotFound),
+		errors.Is(err, domain.ErrGroupNotFound),
+		errors.Is(err, domain.ErrBoardGroupLinkNotFound):
 		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusNotFound, apperrors.ErrCodeNotFound, err.Error()))
 	case errors.Is(err, domain.ErrConstraintViolation),
 		errors.Is(err, domain.ErrAtLeastOneProvided),
@@ -43,7 +46,9 @@ func handleBoardError(ctx *gin.Context, err error) {
 		errors.Is(err, domain.ErrBoardNoMembersToInvite):
 		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, err.Error()))
 	case errors.Is(err, domain.ErrBoardAlreadyMember),
-		errors.Is(err, domain.ErrBoardCannotJoin):
+		errors.Is(err, domain.ErrBoardCannotJoin),
+		errors.Is(err, domain.ErrAlreadyBoardOwner),
+		errors.Is(err, domain.ErrBoardOwnerChanged):
 		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusConflict, apperrors.ErrCodeConflict, err.Error()))
 	default:
 		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusInternalServerError, apperrors.ErrCodeInternal, err.Error()))
@@ -369,6 +374,65 @@ func (bh *BoardHandler) UpdateBoard(ctx *gin.Context) {
 	)
 }
 
+// TransferOwnership godoc
+// @Summary Transfer board ownership
+// @Description Allowed to the board owner or a workspace admin. The new owner must already be a board member and not a workspace guest; they become the board's creator and BOARD_OWNER, and the previous owner stays on the board as BOARD_MEMBER.
+// @Tags board
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param workspace_id path string true "Workspace UUID"
+// @Param board_id path string true "Board UUID"
+// @Param body body request.TransferBoardOwnershipRequest true "New owner"
+// @Success 200 {object} response.BoardTransferOwnershipSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400ValidationDoc "Validation error"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Unauthorized"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "Not the board owner or a workspace admin, or the new owner is a guest"
+// @Failure 404 {object} response.Failure404NotFoundDoc "Board not found or new owner not a board member"
+// @Failure 409 {object} response.Failure409ConflictDoc "Already the owner, or the owner changed meanwhile"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /workspace/{workspace_id}/board/{board_id}/transfer-ownership [post]
+func (bh *BoardHandler) TransferOwnership(ctx *gin.Context) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return
+	}
+
+	workspaceID, boardID, ok := parseBoardPathParams(ctx)
+	if !ok {
+		return
+	}
+
+	var req request.TransferBoardOwnershipRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		response.HandleValidationError(ctx, err)
+		return
+	}
+
+	out, err := bh.boardUseCase.TransferOwnership(ctx.Request.Context(), board.TransferOwnershipInput{
+		RequesterID: userID,
+		WorkspaceID: workspaceID,
+		BoardID:     boardID,
+		NewOwnerID:  req.UserID,
+	})
+	if err != nil {
+		var validationErrs validator.ValidationErrors
+		if errors.As(err, &validationErrs) {
+			response.HandleValidationError(ctx, err)
+			return
+		}
+
+		handleBoardError(ctx, err)
+		return
+	}
+
+	response.GenerateSuccessResponse(
+		ctx,
+		"Board ownership transferred successfully",
+		response.BoardDTOToResponse(out.Board),
+	)
+}
+
 // SetBoardArchivedStatus godoc
 // @Summary Set board archived flag
 // @Tags board
@@ -428,14 +492,15 @@ func (bh *BoardHandler) SetBoardArchivedStatus(ctx *gin.Context) {
 }
 
 // InviteMembersToBoard godoc
-// @Summary Invite workspace members to the board by user id
+// @Summary Invite workspace members to the board by user id or group
+// @Description Send either user_ids or group_id. A group invite adds the group's members who are not on the board yet; with sync_group the board also follows later changes to the group.
 // @Tags board
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param workspace_id path string true "Workspace UUID"
 // @Param board_id path string true "Board UUID"
-// @Param body body request.InviteMemberBoardRequest true "User IDs to invite"
+// @Param body body request.InviteMemberBoardRequest true "User IDs or group to invite"
 // @Success 200 {object} response.BoardInviteMembersSuccessDoc "OK"
 // @Failure 400 {object} response.Failure400ValidationDoc "Validation error"
 // @Failure 401 {object} response.Failure401UnauthorizedDoc "Unauthorized"
@@ -466,6 +531,8 @@ func (bh *BoardHandler) InviteMembersToBoard(ctx *gin.Context) {
 		WorkspaceID: workspaceID,
 		BoardID:     boardID,
 		UserIDs:     req.UserIDs,
+		GroupID:     req.GroupID,
+		SyncGroup:   req.SyncGroup,
 	}
 
 	err := bh.boardUseCase.InviteMember(ctx.Request.Context(), input)
@@ -487,6 +554,53 @@ func (bh *BoardHandler) InviteMembersToBoard(ctx *gin.Context) {
 	)
 }
 
+// UnlinkGroup godoc
+// @Summary Stop syncing the board with a group
+// @Description Later group membership changes no longer affect the board. Members already added stay.
+// @Tags board
+// @Produce json
+// @Security BearerAuth
+// @Param workspace_id path string true "Workspace UUID"
+// @Param board_id path string true "Board UUID"
+// @Param group_id path string true "Group UUID"
+// @Success 200 {object} response.BoardUnlinkGroupSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400BadRequestDoc "Invalid ids"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Unauthorized"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "Forbidden"
+// @Failure 404 {object} response.Failure404NotFoundDoc "Board not found or group not linked"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /workspace/{workspace_id}/board/{board_id}/groups/{group_id} [delete]
+func (bh *BoardHandler) UnlinkGroup(ctx *gin.Context) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return
+	}
+
+	workspaceID, boardID, ok := parseBoardPathParams(ctx)
+	if !ok {
+		return
+	}
+
+	groupID, ok := helper.ParseUUIDParams(ctx, "group_id")
+	if !ok {
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, "Invalid or missing group id"))
+		return
+	}
+
+	err := bh.boardUseCase.UnlinkGroup(ctx.Request.Context(), board.UnlinkGroupInput{
+		RequesterID: userID,
+		WorkspaceID: workspaceID,
+		BoardID:     boardID,
+		GroupID:     groupID,
+	})
+	if err != nil {
+		handleBoardError(ctx, err)
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Group unlinked from the board", nil)
+}
+
 // RemoveMemberFromBoard godoc
 // @Summary Remove a member from the board
 // @Tags board
@@ -0,0 +1,26 @@
+package handler
+
+import (
+	"collabotask/internal/adapter/http/response"
+	infraauth "collabotask/internal/infrastructure/auth"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type JWKSHandler struct {
+	jwtKeys *infraauth.JWTKeySet
+}
+
+func NewJWKSHandler(jwtKeys *infraauth.JWTKeySet) *JWKSHandler {
+	return &JWKSHandler{jwtKeys: jwtKeys}
+}
+
+// GetJWKS lists every public key access tokens may currently be signed
+// with, identified by the "kid" token header. It is mounted outside the
+// versioned API at /.well-known/jwks.json, so it is left out of the Swagger
+// spec. The set is empty while tokens are signed with HS256.
+func (h *JWKSHandler) GetJWKS(ctx *gin.Context) {
+	ctx.Header("Cache-Control", "public, max-age=300")
+	ctx.JSON(http.StatusOK, response.JWKSResponse{Keys: h.jwtKeys.JWKS()})
+}
@@ -0,0 +1,215 @@
+package handler
+
+import (
+	apperrors "collabotask/internal/adapter/http/errors"
+	"collabotask/internal/adapter/http/helper"
+	"collabotask/internal/adapter/http/request"
+	"collabotask/internal/adapter/http/response"
+	"collabotask/internal/domain"
+	"collabotask/internal/usecase/auth"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MFAHandler struct {
+	authUseCase auth.AuthUseCase
+}
+
+func NewMFAHandler(authUseCase auth.AuthUseCase) *MFAHandler {
+	return &MFAHandler{authUseCase: authUseCase}
+}
+
+// GetMFAStatus godoc
+// @Summary Get two-factor authentication status
+// @Tags user
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.MFAStatusSuccessDoc "OK"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Unauthorized"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /user/mfa [get]
+func (h *MFAHandler) GetMFAStatus(ctx *gin.Context) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return
+	}
+
+	out, err := h.authUseCase.GetMFAStatus(ctx.Request.Context(), userID)
+	if err != nil {
+		handleMFAError(ctx, err)
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Two-factor status retrieved successfully", response.MFAStatusResponse{
+		Enabled:                out.Enabled,
+		RecoveryCodesRemaining: out.RecoveryCodesRemaining,
+	})
+}
+
+// EnrollTOTP godoc
+// @Summary Start TOTP enrolment
+// @Description Generates a new authenticator secret and its otpauth:// URI. Two-factor authentication stays off until the secret is confirmed with a code. Calling this again before confirming replaces the pending secret.
+// @Tags user
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.MFAEnrollSuccessDoc "OK"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Unauthorized"
+// @Failure 404 {object} response.Failure404NotFoundDoc "User not found"
+// @Failure 409 {object} response.Failure409ConflictDoc "Two-factor authentication already enabled"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /user/mfa/totp [post]
+func (h *MFAHandler) EnrollTOTP(ctx *gin.Context) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return
+	}
+
+	out, err := h.authUseCase.EnrollTOTP(ctx.Request.Context(), userID)
+	if err != nil {
+		handleMFAError(ctx, err)
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "TOTP enrolment started", response.TOTPEnrollmentResponse{
+		Secret: out.Secret,
+		URI:    out.URI,
+	})
+}
+
+// ConfirmTOTP godoc
+// @Summary Confirm TOTP enrolment
+// @Description Enables two-factor authentication once a code from the authenticator app is accepted. The returned recovery codes are only shown once.
+// @Tags user
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body request.ConfirmTOTPRequest true "Code from the authenticator app"
+// @Success 200 {object} response.MFARecoveryCodesSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400ValidationDoc "Validation error, invalid code or no pending enrolment"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Unauthorized"
+// @Failure 409 {object} response.Failure409ConflictDoc "Two-factor authentication already enabled"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /user/mfa/totp/confirm [post]
+func (h *MFAHandler) ConfirmTOTP(ctx *gin.Context) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return
+	}
+
+	var req request.ConfirmTOTPRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		response.HandleValidationError(ctx, err)
+		return
+	}
+
+	out, err := h.authUseCase.ConfirmTOTP(ctx.Request.Context(), auth.ConfirmTOTPInput{
+		UserID: userID,
+		Code:   req.Code,
+	})
+	if err != nil {
+		handleMFAError(ctx, err)
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Two-factor authentication enabled", response.RecoveryCodesResponse{
+		RecoveryCodes: out.RecoveryCodes,
+	})
+}
+
+// DisableTOTP godoc
+// @Summary Disable two-factor authentication
+// @Description Requires the current password, or a TOTP or recovery code for accounts without one. Removes the authenticator secret and every recovery code.
+// @Tags user
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body request.MFAPasswordRequest true "Current password or two-factor code"
+// @Success 200 {object} response.MFADisableSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400ValidationDoc "Validation error, incorrect password or two-factor authentication not enabled"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Unauthorized"
+// @Failure 404 {object} response.Failure404NotFoundDoc "User not found"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /user/mfa/totp [delete]
+func (h *MFAHandler) DisableTOTP(ctx *gin.Context) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return
+	}
+
+	var req request.MFAPasswordRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		response.HandleValidationError(ctx, err)
+		return
+	}
+
+	err := h.authUseCase.DisableTOTP(ctx.Request.Context(), auth.DisableTOTPInput{
+		UserID:   userID,
+		Password: req.Password,
+		Code:     req.Code,
+	})
+	if err != nil {
+		handleMFAError(ctx, err)
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Two-factor authentication disabled", nil)
+}
+
+// RegenerateRecoveryCodes godoc
+// @Summary Regenerate recovery codes
+// @Description Requires the current password, or a TOTP or recovery code for accounts without one. Replaces every existing recovery code, used or not.
+// @Tags user
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body request.MFAPasswordRequest true "Current password or two-factor code"
+// @Success 200 {object} response.MFARecoveryCodesSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400ValidationDoc "Validation error, incorrect password or two-factor authentication not enabled"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Unauthorized"
+// @Failure 404 {object} response.Failure404NotFoundDoc "User not found"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /user/mfa/recovery-codes [post]
+func (h *MFAHandler) RegenerateRecoveryCodes(ctx *gin.Context) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return
+	}
+
+	var req request.MFAPasswordRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		response.HandleValidationError(ctx, err)
+		return
+	}
+
+	out, err := h.authUseCase.RegenerateRecoveryCodes(ctx.Request.Context(), auth.RegenerateRecoveryCodesInput{
+		UserID:   userID,
+		Password: req.Password,
+		Code:     req.Code,
+	})
+	if err != nil {
+		handleMFAError(ctx, err)
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Recovery codes regenerated", response.RecoveryCodesResponse{
+		RecoveryCodes: out.RecoveryCodes,
+	})
+}
+
+func handleMFAError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, domain.ErrIncorrectPassword),
+		errors.Is(err, domain.ErrPasswordNotSet),
+		errors.Is(err, domain.ErrInvalidMFACode),
+		errors.Is(err, domain.ErrMFANotEnabled):
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, err.Error()))
+	case errors.Is(err, domain.ErrMFAAlreadyEnabled):
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusConflict, apperrors.ErrCodeConflict, err.Error()))
+	case errors.Is(err, domain.ErrUserNotFound):
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusNotFound, apperrors.ErrCodeNotFound, err.Error()))
+	default:
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusInternalServerError, apperrors.ErrCodeInternal, err.Error()))
+	}
+}
@@ -0,0 +1,111 @@
+package handler
+
+import (
+	apperrors "collabotask/internal/adapter/http/errors"
+	"collabotask/internal/adapter/http/helper"
+	"collabotask/internal/adapter/http/middleware"
+	"collabotask/internal/adapter/http/response"
+	"collabotask/internal/domain"
+	"collabotask/internal/usecase/session"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type SessionHandler struct {
+	sessionUseCase session.SessionUseCase
+}
+
+func NewSessionHandler(sessionUseCase session.SessionUseCase) *SessionHandler {
+	return &SessionHandler{sessionUseCase: sessionUseCase}
+}
+
+func handleSessionError(ctx *gin.Context, err error) {
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		response.HandleValidationError(ctx, err)
+		return
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrSessionNotFound):
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusNotFound, apperrors.ErrCodeNotFound, err.Error()))
+	default:
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusInternalServerError, apperrors.ErrCodeInternal, err.Error()))
+	}
+}
+
+// ListSessions godoc
+// @Summary List active sessions
+// @Description Lists the devices the caller is signed in on. Last-seen times are updated on login and on every token refresh. The session making the request is flagged as current.
+// @Tags user
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.SessionListSuccessDoc "OK"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Unauthorized"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /user/sessions [get]
+func (h *SessionHandler) ListSessions(ctx *gin.Context) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return
+	}
+
+	input := session.ListSessionsInput{UserID: userID}
+	if claims, ok := middleware.GetTokenClaims(ctx); ok {
+		input.CurrentSessionID = claims.SessionID
+	}
+
+	out, err := h.sessionUseCase.ListSessions(ctx.Request.Context(), input)
+	if err != nil {
+		handleSessionError(ctx, err)
+		return
+	}
+
+	sessions := make([]response.SessionResponse, 0, len(out.Sessions))
+	for _, s := range out.Sessions {
+		sessions = append(sessions, response.SessionDTOToResponse(s))
+	}
+
+	response.GenerateSuccessResponse(ctx, "Sessions retrieved successfully", sessions)
+}
+
+// RevokeSession godoc
+// @Summary Sign out a session
+// @Description Revokes the session's refresh tokens and rejects its access tokens from now on. Requires a regular login session.
+// @Tags user
+// @Produce json
+// @Security BearerAuth
+// @Param session_id path string true "Session UUID"
+// @Success 200 {object} response.SessionRevokeSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400BadRequestDoc "Invalid session id"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Unauthorized"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "Called with a personal access token"
+// @Failure 404 {object} response.Failure404NotFoundDoc "Session not found"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /user/sessions/{session_id} [delete]
+func (h *SessionHandler) RevokeSession(ctx *gin.Context) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return
+	}
+
+	sessionID, ok := helper.ParseUUIDParams(ctx, "session_id")
+	if !ok {
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, "Invalid session id"))
+		return
+	}
+
+	err := h.sessionUseCase.RevokeSession(ctx.Request.Context(), session.RevokeSessionInput{
+		UserID:    userID,
+		SessionID: sessionID,
+	})
+	if err != nil {
+		handleSessionError(ctx, err)
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Session revoked successfully", nil)
+}
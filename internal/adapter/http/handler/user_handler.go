@@ -3,6 +3,7 @@ package handler
 import (
 	apperrors "collabotask/internal/adapter/http/errors"
 	"collabotask/internal/adapter/http/helper"
+	"collabotask/internal/adapter/http/request"
 	"collabotask/internal/adapter/http/response"
 	"collabotask/internal/domain"
 	"collabotask/internal/usecase/auth"
@@ -10,6 +11,7 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
 )
 
 type UserHandler struct {
@@ -49,10 +51,173 @@ func (h *UserHandler) GetProfile(ctx *gin.Context) {
 	}
 
 	response.GenerateSuccessResponse(ctx, "Profile retrieved successfully", response.UserResponse{
-		ID:         user.ID,
-		Email:      user.Email,
-		Name:       user.Name,
-		AvatarURL:  user.AvatarURL,
-		SystemRole: user.SystemRole,
+		ID:            user.ID,
+		Email:         user.Email,
+		Name:          user.Name,
+		AvatarURL:     user.AvatarURL,
+		SystemRole:    user.SystemRole,
+		EmailVerified: user.EmailVerified,
+	})
+}
+
+// UpdateProfile godoc
+// @Summary Update current user profile
+// @Description Partially updates the authenticated user's name and avatar. Sending "avatar_url": null clears the avatar.
+// @Tags user
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body request.UpdateProfileRequest true "Partial update"
+// @Success 200 {object} response.UserUpdateProfileSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400ValidationDoc "Validation error"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Unauthorized"
+// @Failure 404 {object} response.Failure404NotFoundDoc "User not found"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /user/profile [patch]
+func (h *UserHandler) UpdateProfile(ctx *gin.Context) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return
+	}
+
+	var req request.UpdateProfileRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		response.HandleValidationError(ctx, err)
+		return
+	}
+
+	input := auth.UpdateProfileInput{
+		UserID: userID,
+		Name:   req.Name,
+	}
+	if req.AvatarURL.Present {
+		input.AvatarURLPresent = true
+		input.AvatarURL = req.AvatarURL.Value
+	}
+
+	user, err := h.authUseCase.UpdateProfile(ctx.Request.Context(), input)
+	if err != nil {
+		var validationErrs validator.ValidationErrors
+		if errors.As(err, &validationErrs) {
+			response.HandleValidationError(ctx, err)
+			return
+		}
+
+		switch {
+		case errors.Is(err, domain.ErrAtLeastOneProvided):
+			response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, err.Error()))
+		case errors.Is(err, domain.ErrUserNotFound):
+			response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusNotFound, apperrors.ErrCodeNotFound, err.Error()))
+		default:
+			response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusInternalServerError, apperrors.ErrCodeInternal, err.Error()))
+		}
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Profile updated successfully", response.UserDTOToResponse(*user))
+}
+
+// ChangePassword godoc
+// @Summary Change current user password
+// @Description Verifies the current password, stores the new one and signs out every other session. The caller receives a fresh token pair. Accounts without a password must use the password reset flow instead.
+// @Tags user
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body request.ChangePasswordRequest true "Current and new password"
+// @Success 200 {object} response.UserChangePasswordSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400ValidationDoc "Validation error or incorrect current password"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Unauthorized"
+// @Failure 404 {object} response.Failure404NotFoundDoc "User not found"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /user/password [post]
+func (h *UserHandler) ChangePassword(ctx *gin.Context) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return
+	}
+
+	var req request.ChangePasswordRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		response.HandleValidationError(ctx, err)
+		return
+	}
+
+	out, err := h.authUseCase.ChangePassword(ctx.Request.Context(), auth.ChangePasswordInput{
+		UserID:          userID,
+		CurrentPassword: req.CurrentPassword,
+		NewPassword:     req.NewPassword,
+		Client:          clientInfo(ctx),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrIncorrectPassword),
+			errors.Is(err, domain.ErrPasswordNotSet),
+			errors.Is(err, domain.ErrInvalidMFACode):
+			response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, err.Error()))
+		case errors.Is(err, domain.ErrUserNotFound):
+			response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusNotFound, apperrors.ErrCodeNotFound, err.Error()))
+		default:
+			response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusInternalServerError, apperrors.ErrCodeInternal, err.Error()))
+		}
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Password changed successfully", response.TokenResponse{
+		Token:        out.Token,
+		RefreshToken: out.RefreshToken,
+	})
+}
+
+// DeleteAccount godoc
+// @Summary Delete current user account
+// @Description Requires the current password, or a TOTP or recovery code for accounts without one. Owned workspaces and boards are handed to the next admin/owner (workspaces without other members are deleted), authored cards are reassigned and the user row is anonymised.
+// @Tags user
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body request.DeleteAccountRequest true "Current password or two-factor code"
+// @Success 200 {object} response.UserDeleteAccountSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400ValidationDoc "Validation error or incorrect password"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Unauthorized"
+// @Failure 404 {object} response.Failure404NotFoundDoc "User not found"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /user [delete]
+func (h *UserHandler) DeleteAccount(ctx *gin.Context) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return
+	}
+
+	var req request.DeleteAccountRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		response.HandleValidationError(ctx, err)
+		return
+	}
+
+	out, err := h.authUseCase.DeleteAccount(ctx.Request.Context(), auth.DeleteAccountInput{
+		UserID:   userID,
+		Password: req.Password,
+		Code:     req.Code,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrIncorrectPassword),
+			errors.Is(err, domain.ErrPasswordNotSet),
+			errors.Is(err, domain.ErrInvalidMFACode):
+			response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, err.Error()))
+		case errors.Is(err, domain.ErrUserNotFound):
+			response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusNotFound, apperrors.ErrCodeNotFound, err.Error()))
+		default:
+			response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusInternalServerError, apperrors.ErrCodeInternal, err.Error()))
+		}
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Account deleted successfully", response.AccountDeletionResponse{
+		TransferredWorkspaces: out.TransferredWorkspaces,
+		DeletedWorkspaces:     out.DeletedWorkspaces,
+		TransferredBoards:     out.TransferredBoards,
+		ReassignedCards:       out.ReassignedCards,
 	})
 }
@@ -1,14 +1,12 @@
 package handler
 
-//TODO:
-// - Add update workspace handler
-
 import (
 	apperrors "collabotask/internal/adapter/http/errors"
 	"collabotask/internal/adapter/http/helper"
 	"collabotask/internal/adapter/http/request"
 	"collabotask/internal/adapter/http/response"
 	"collabotask/internal/domain"
+	"collabotask/internal/domain/entity"
 	"collabotask/internal/usecase/workspace"
 	"errors"
 	"net/http"
@@ -30,15 +28,40 @@ func NewWorkspaceHandler(workspaceUseCase workspace.WorkspaceUseCase) *Workspace
 func handleWorkspaceError(ctx *gin.Context, err error) {
 	switch {
 	case errors.Is(err, domain.ErrNotWorkspaceAdmin),
-		errors.Is(err, domain.ErrUserNotInWorkspace):
+		errors.Is(err, domain.ErrWorkspaceGuest),
+		errors.Is(err, domain.ErrNotWorkspaceOwner),
+		errors.Is(err, domain.ErrUserNotInWorkspace),
+		errors.Is(err, domain.ErrEmailNotVerified):
 		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusForbidden, apperrors.ErrCodeForbidden, err.Error()))
 	case errors.Is(err, domain.ErrUserNotFound),
 		errors.Is(err, domain.ErrWorkspaceNotFound),
-		errors.Is(err, domain.ErrMemberNotFound):
+		errors.Is(err, domain.ErrMemberNotFound),
+		errors.Is(err, domain.ErrJoinLinkNotFound),
+		errors.Is(err, domain.ErrEmailDomainNotFound),
+		errors.Is(err, domain.ErrGroupNotFound),
+		errors.Is(err, domain.ErrGroupMemberNotFound),
+		errors.Is(err, domain.ErrBoardNotFound):
 		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusNotFound, apperrors.ErrCodeNotFound, err.Error()))
-	case errors.Is(err, domain.ErrAlreadyMember):
+	case errors.Is(err, domain.ErrAlreadyMember),
+		errors.Is(err, domain.ErrCannotRemoveOwner),
+		errors.Is(err, domain.ErrCannotDemoteOwner),
+		errors.Is(err, domain.ErrLastWorkspaceAdmin),
+		errors.Is(err, domain.ErrOwnerCannotLeave),
+		errors.Is(err, domain.ErrEmailDomainAlreadyAdded),
+		errors.Is(err, domain.ErrGroupNameTaken),
+		errors.Is(err, domain.ErrGuestCannotOwnBoards):
 		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusConflict, apperrors.ErrCodeConflict, err.Error()))
-	case errors.Is(err, domain.ErrCannotRemoveYourself):
+	case errors.Is(err, domain.ErrInvitationEmailMismatch):
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusForbidden, apperrors.ErrCodeForbidden, err.Error()))
+	case errors.Is(err, domain.ErrCannotRemoveYourself),
+		errors.Is(err, domain.ErrAlreadyWorkspaceOwner),
+		errors.Is(err, domain.ErrWorkspaceNameMismatch),
+		errors.Is(err, domain.ErrAtLeastOneProvided),
+		errors.Is(err, domain.ErrInvalidInvitation),
+		errors.Is(err, domain.ErrInvalidJoinLink),
+		errors.Is(err, domain.ErrInvalidMemberCursor),
+		errors.Is(err, domain.ErrInvalidEmailDomain),
+		errors.Is(err, domain.ErrEmailDomainVerificationFailed):
 		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, err.Error()))
 	default:
 		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusInternalServerError, apperrors.ErrCodeInternal, err.Error()))
@@ -131,21 +154,138 @@ func (wh *WorkspaceHandler) GetWorkspaces(ctx *gin.Context) {
 	)
 }
 
+// UpdateWorkspace godoc
+// @Summary Update workspace name or description
+// @Description Requires workspace admin. Only provided fields change; sending description as null or empty clears it. At least one field is required.
+// @Tags workspace
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param workspace_id path string true "Workspace UUID"
+// @Param body body request.UpdateWorkspaceRequest true "Partial update"
+// @Success 200 {object} response.WorkspaceUpdateSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400ValidationDoc "Invalid workspace id, validation error, or no field provided"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Missing or invalid Bearer token"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "Requester is not workspace admin"
+// @Failure 404 {object} response.Failure404NotFoundDoc "Workspace not found"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /workspace/{workspace_id} [patch]
+func (wh *WorkspaceHandler) UpdateWorkspace(ctx *gin.Context) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := helper.ParseUUIDParams(ctx, "workspace_id")
+	if !ok {
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, "Invalid workspace id"))
+		return
+	}
+
+	var req request.UpdateWorkspaceRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		response.HandleValidationError(ctx, err)
+		return
+	}
+
+	input := workspace.UpdateWorkspaceInput{
+		RequesterID: userID,
+		WorkspaceID: workspaceID,
+		Name:        req.Name,
+	}
+	if req.Description.Present {
+		input.DescriptionPresent = true
+		input.Description = req.Description.Value
+	}
+
+	out, err := wh.workspaceUseCase.UpdateWorkspace(ctx.Request.Context(), input)
+	if err != nil {
+		var validationErrs validator.ValidationErrors
+		if errors.As(err, &validationErrs) {
+			response.HandleValidationError(ctx, err)
+			return
+		}
+
+		handleWorkspaceError(ctx, err)
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Workspace updated successfully", response.WorkspaceDTOToResponse(out.Workspace))
+}
+
+// DeleteWorkspace godoc
+// @Summary Delete a workspace
+// @Description Only the owner may delete, and confirm_name must repeat the workspace name exactly. Boards, columns, cards and memberships are removed with it; the response reports how many.
+// @Tags workspace
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param workspace_id path string true "Workspace UUID"
+// @Param body body request.DeleteWorkspaceRequest true "Name confirmation"
+// @Success 200 {object} response.WorkspaceDeleteSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400ValidationDoc "Invalid workspace id, body, or name confirmation mismatch"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Missing or invalid Bearer token"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "Requester is not the workspace owner"
+// @Failure 404 {object} response.Failure404NotFoundDoc "Workspace not found"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /workspace/{workspace_id} [delete]
+func (wh *WorkspaceHandler) DeleteWorkspace(ctx *gin.Context) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := helper.ParseUUIDParams(ctx, "workspace_id")
+	if !ok {
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, "Invalid workspace id"))
+		return
+	}
+
+	var req request.DeleteWorkspaceRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		response.HandleValidationError(ctx, err)
+		return
+	}
+
+	out, err := wh.workspaceUseCase.DeleteWorkspace(ctx.Request.Context(), workspace.DeleteWorkspaceInput{
+		RequesterID: userID,
+		WorkspaceID: workspaceID,
+		ConfirmName: req.ConfirmName,
+	})
+	if err != nil {
+		var validationErrs validator.ValidationErrors
+		if errors.As(err, &validationErrs) {
+			response.HandleValidationError(ctx, err)
+			return
+		}
+
+		handleWorkspaceError(ctx, err)
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Workspace deleted successfully", response.WorkspaceDeletionResponse{
+		DeletedBoards:  out.DeletedBoards,
+		DeletedColumns: out.DeletedColumns,
+		DeletedCards:   out.DeletedCards,
+		RemovedMembers: out.RemovedMembers,
+	})
+}
+
 // InviteMember godoc
 // @Summary Invite users to a workspace by email
-// @Description Requires workspace admin. Invalid workspace id or body validation returns 400.
+// @Description Requires workspace admin. Each email gets its own result: registered users are ADDED (all in one transaction), addresses without an account are INVITED by email and join when they register with, or accept, its token. ALREADY_MEMBER, UNVERIFIED (when verified emails are required) and INVALID addresses are skipped, and FAILED means the invitation email could not be sent. Inviting an address again resends its invitation. Role defaults to MEMBER. Responds 200 when every email was added or invited and 207 otherwise.
 // @Tags workspace
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param workspace_id path string true "Workspace UUID"
 // @Param body body request.InviteMemberRequest true "Email list"
-// @Success 200 {object} response.WorkspaceInviteSuccessDoc "OK (message from use case; data may be null)"
-// @Failure 400 {object} response.Failure400ValidationDoc "Validation failed, invalid workspace id, or use case validation"
+// @Success 200 {object} response.WorkspaceInviteSuccessDoc "Every email added or invited"
+// @Success 207 {object} response.WorkspaceInviteSuccessDoc "Some emails were skipped; see each result"
+// @Failure 400 {object} response.Failure400ValidationDoc "Validation failed or invalid workspace id"
 // @Failure 401 {object} response.Failure401UnauthorizedDoc "Missing or invalid Bearer token"
 // @Failure 403 {object} response.Failure403ForbiddenDoc "Requester is not workspace admin"
-// @Failure 404 {object} response.Failure404NotFoundDoc "User not found"
-// @Failure 409 {object} response.Failure409ConflictDoc "User already in workspace"
+// @Failure 409 {object} response.Failure409ConflictDoc "A user joined concurrently; nothing was added"
 // @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
 // @Router /workspace/{workspace_id}/member/invite [post]
 func (wh *WorkspaceHandler) InviteMember(ctx *gin.Context) {
@@ -173,6 +313,7 @@ func (wh *WorkspaceHandler) InviteMember(ctx *gin.Context) {
 		RequesterID: userID,
 		WorkspaceID: workspaceID,
 		Emails:      req.Emails,
+		Role:        entity.WorkspaceRole(req.Role),
 	}
 
 	out, err := wh.workspaceUseCase.InviteMember(ctx.Request.Context(), input)
@@ -187,27 +328,78 @@ func (wh *WorkspaceHandler) InviteMember(ctx *gin.Context) {
 		return
 	}
 
-	response.GenerateSuccessResponse(ctx, out.Message, nil)
+	results := make([]response.WorkspaceInviteResultResponse, 0, len(out.Results))
+	for _, r := range out.Results {
+		results = append(results, response.WorkspaceInviteResultResponse{
+			Email:  r.Email,
+			Status: string(r.Status),
+		})
+	}
+
+	status := http.StatusOK
+	if !out.AllSucceeded() {
+		status = http.StatusMultiStatus
+	}
+
+	response.GenerateSuccessResponse(ctx, out.Message, response.WorkspaceInviteResponse{Results: results}, status)
 }
 
-// RemoveMember godoc
-// @Summary Remove a member from a workspace
-// @Description Requires admin or appropriate permission; cannot remove yourself (400).
+// AcceptInvitation godoc
+// @Summary Accept a workspace invitation
+// @Description Joins the workspace of an emailed invitation with its role. The invitation must have been sent to the caller's email address. Already being a member keeps the current role.
+// @Tags workspace
+// @Produce json
+// @Security BearerAuth
+// @Param token path string true "Invitation token from the email"
+// @Success 200 {object} response.WorkspaceAcceptInvitationSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400BadRequestDoc "Invalid or expired invitation"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Missing or invalid Bearer token"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "Invitation was sent to a different email address"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /invitations/{token}/accept [post]
+func (wh *WorkspaceHandler) AcceptInvitation(ctx *gin.Context) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return
+	}
+
+	out, err := wh.workspaceUseCase.AcceptInvitation(ctx.Request.Context(), workspace.AcceptInvitationInput{
+		UserID: userID,
+		Token:  ctx.Param("token"),
+	})
+	if err != nil {
+		var validationErrs validator.ValidationErrors
+		if errors.As(err, &validationErrs) {
+			response.HandleValidationError(ctx, err)
+			return
+		}
+
+		handleWorkspaceError(ctx, err)
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Invitation accepted successfully", response.AcceptInvitationResponse{
+		Workspace: response.WorkspaceDTOToResponse(out.Workspace),
+		Role:      out.Role,
+	})
+}
+
+// CreateJoinLink godoc
+// @Summary Create a workspace join link
+// @Description Requires workspace admin. Anyone signed in who holds the code can join with the link's role (MEMBER by default) until it expires, reaches max_uses or is revoked; both limits are optional. The code is only returned in this response.
 // @Tags workspace
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param workspace_id path string true "Workspace UUID"
-// @Param user_id path string true "Member user UUID to remove"
-// @Success 200 {object} response.WorkspaceRemoveMemberSuccessDoc "OK"
-// @Failure 400 {object} response.Failure400BadRequestDoc "Invalid workspace id or invalid user id in path"
-// @Failure 400 {object} response.Failure400ValidationDoc "Cannot remove yourself or validation error"
+// @Param body body request.CreateJoinLinkRequest true "Role, max uses and lifetime"
+// @Success 201 {object} response.WorkspaceJoinLinkCreateSuccessDoc "Created"
+// @Failure 400 {object} response.Failure400ValidationDoc "Invalid workspace id or body"
 // @Failure 401 {object} response.Failure401UnauthorizedDoc "Missing or invalid Bearer token"
-// @Failure 403 {object} response.Failure403ForbiddenDoc "Not admin or user not in workspace"
-// @Failure 404 {object} response.Failure404NotFoundDoc "Member not found"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "Requester is not workspace admin"
 // @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
-// @Router /workspace/{workspace_id}/member/remove/{user_id} [delete]
-func (wh *WorkspaceHandler) RemoveMember(ctx *gin.Context) {
+// @Router /workspace/{workspace_id}/join-links [post]
+func (wh *WorkspaceHandler) CreateJoinLink(ctx *gin.Context) {
 	userID, ok := helper.GetAndCheckUserID(ctx)
 	if !ok {
 		return
@@ -219,43 +411,94 @@ func (wh *WorkspaceHandler) RemoveMember(ctx *gin.Context) {
 		return
 	}
 
-	memberUserID, ok := helper.ParseUUIDParams(ctx, "user_id")
+	var req request.CreateJoinLinkRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		response.HandleValidationError(ctx, err)
+		return
+	}
+
+	out, err := wh.workspaceUseCase.CreateJoinLink(ctx.Request.Context(), workspace.CreateJoinLinkInput{
+		RequesterID:    userID,
+		WorkspaceID:    workspaceID,
+		Role:           entity.WorkspaceRole(req.Role),
+		MaxUses:        req.MaxUses,
+		ExpiresInHours: req.ExpiresInHours,
+	})
+	if err != nil {
+		var validationErrs validator.ValidationErrors
+		if errors.As(err, &validationErrs) {
+			response.HandleValidationError(ctx, err)
+			return
+		}
+
+		handleWorkspaceError(ctx, err)
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Join link created successfully", response.CreatedWorkspaceJoinLinkResponse{
+		WorkspaceJoinLinkResponse: response.WorkspaceJoinLinkDTOToResponse(out.JoinLink),
+		Code:                      out.Code,
+	}, http.StatusCreated)
+}
+
+// ListJoinLinks godoc
+// @Summary List active workspace join links
+// @Description Requires workspace admin. Revoked, expired and used up links are left out. Only the code prefix is shown.
+// @Tags workspace
+// @Produce json
+// @Security BearerAuth
+// @Param workspace_id path string true "Workspace UUID"
+// @Success 200 {object} response.WorkspaceJoinLinkListSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400BadRequestDoc "Invalid workspace id"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Missing or invalid Bearer token"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "Requester is not workspace admin"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /workspace/{workspace_id}/join-links [get]
+func (wh *WorkspaceHandler) ListJoinLinks(ctx *gin.Context) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
 	if !ok {
-		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, "Invalid user id"))
 		return
 	}
 
-	err := wh.workspaceUseCase.RemoveMember(ctx.Request.Context(), workspace.RemoveMemberInput{
+	workspaceID, ok := helper.ParseUUIDParams(ctx, "workspace_id")
+	if !ok {
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, "Invalid workspace id"))
+		return
+	}
+
+	out, err := wh.workspaceUseCase.ListJoinLinks(ctx.Request.Context(), workspace.ListJoinLinksInput{
 		RequesterID: userID,
 		WorkspaceID: workspaceID,
-		UserID:      memberUserID,
 	})
-
 	if err != nil {
 		handleWorkspaceError(ctx, err)
 		return
 	}
 
-	response.GenerateSuccessResponse(ctx, "Member removed successfully", nil)
+	links := make([]response.WorkspaceJoinLinkResponse, 0, len(out.JoinLinks))
+	for _, link := range out.JoinLinks {
+		links = append(links, response.WorkspaceJoinLinkDTOToResponse(link))
+	}
+
+	response.GenerateSuccessResponse(ctx, "Join links retrieved successfully", links)
 }
 
-// GetWorkspaceDetail godoc
-// @Summary Get workspace detail including members
-// @Description Returns workspace metadata, current user role, and member list.
+// RevokeJoinLink godoc
+// @Summary Revoke a workspace join link
+// @Description Requires workspace admin. Members who already joined through the link stay in the workspace.
 // @Tags workspace
-// @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param workspace_id path string true "Workspace UUID"
-// @Success 200 {object} response.WorkspaceDetailSuccessDoc "OK"
-// @Failure 400 {object} response.Failure400BadRequestDoc "Invalid workspace id in path"
-// @Failure 400 {object} response.Failure400ValidationDoc "Validation error"
+// @Param link_id path string true "Join link UUID"
+// @Success 200 {object} response.WorkspaceJoinLinkRevokeSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400BadRequestDoc "Invalid workspace id or link id"
 // @Failure 401 {object} response.Failure401UnauthorizedDoc "Missing or invalid Bearer token"
-// @Failure 403 {object} response.Failure403ForbiddenDoc "User not in workspace"
-// @Failure 404 {object} response.Failure404NotFoundDoc "Workspace or user not found"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "Requester is not workspace admin"
+// @Failure 404 {object} response.Failure404NotFoundDoc "Join link not found or already revoked"
 // @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
-// @Router /workspace/{workspace_id} [get]
-func (wh *WorkspaceHandler) GetWorkspaceDetail(ctx *gin.Context) {
+// @Router /workspace/{workspace_id}/join-links/{link_id} [delete]
+func (wh *WorkspaceHandler) RevokeJoinLink(ctx *gin.Context) {
 	userID, ok := helper.GetAndCheckUserID(ctx)
 	if !ok {
 		return
@@ -263,22 +506,53 @@ func (wh *WorkspaceHandler) GetWorkspaceDetail(ctx *gin.Context) {
 
 	workspaceID, ok := helper.ParseUUIDParams(ctx, "workspace_id")
 	if !ok {
-		response.GenerateErrorResponse(
-			ctx,
-			apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, "Invalid workspace id"),
-		)
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, "Invalid workspace id"))
 		return
 	}
 
-	input := workspace.GetWorkspaceDetailInput{
+	linkID, ok := helper.ParseUUIDParams(ctx, "link_id")
+	if !ok {
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, "Invalid link id"))
+		return
+	}
+
+	err := wh.workspaceUseCase.RevokeJoinLink(ctx.Request.Context(), workspace.RevokeJoinLinkInput{
 		RequesterID: userID,
 		WorkspaceID: workspaceID,
+		LinkID:      linkID,
+	})
+	if err != nil {
+		handleWorkspaceError(ctx, err)
+		return
 	}
 
-	out, err := wh.workspaceUseCase.GetWorkspaceDetail(
-		ctx.Request.Context(),
-		input,
-	)
+	response.GenerateSuccessResponse(ctx, "Join link revoked successfully", nil)
+}
+
+// JoinByLink godoc
+// @Summary Join a workspace through a join link
+// @Description Adds the caller to the link's workspace with the link's role and counts one use. Existing members get 409 and the use is not counted.
+// @Tags workspace
+// @Produce json
+// @Security BearerAuth
+// @Param code path string true "Join link code"
+// @Success 200 {object} response.WorkspaceJoinSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400BadRequestDoc "Invalid, expired, revoked or used up link"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Missing or invalid Bearer token"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "Email not verified"
+// @Failure 409 {object} response.Failure409ConflictDoc "Already a member of the workspace"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /join/{code} [post]
+func (wh *WorkspaceHandler) JoinByLink(ctx *gin.Context) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return
+	}
+
+	out, err := wh.workspaceUseCase.JoinByLink(ctx.Request.Context(), workspace.JoinByLinkInput{
+		UserID: userID,
+		Code:   ctx.Param("code"),
+	})
 	if err != nil {
 		var validationErrs validator.ValidationErrors
 		if errors.As(err, &validationErrs) {
@@ -290,5 +564,921 @@ func (wh *WorkspaceHandler) GetWorkspaceDetail(ctx *gin.Context) {
 		return
 	}
 
-	response.GenerateSuccessResponse(ctx, "Workspace detail retrieved successfully", response.WorkspaceDetailDTOToResponse(out.Workspace))
+	response.GenerateSuccessResponse(ctx, "Joined workspace successfully", response.AcceptInvitationResponse{
+		Workspace: response.WorkspaceDTOToResponse(out.Workspace),
+		Role:      out.Role,
+	})
+}
+
+// AddEmailDomain godoc
+// @Summary Add an email domain for automatic joining
+// @Description Requires workspace admin. Once the domain is verified, users whose verified email is on it join the workspace as MEMBER when they register, verify their email or log in, and are added to the optional default boards. The response includes the DNS TXT record to publish before calling verify.
+// @Tags workspace
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param workspace_id path string true "Workspace UUID"
+// @Param body body request.AddEmailDomainRequest true "Domain and default boards"
+// @Success 201 {object} response.WorkspaceEmailDomainCreateSuccessDoc "Created"
+// @Failure 400 {object} response.Failure400ValidationDoc "Invalid workspace id, body or domain"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Missing or invalid Bearer token"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "Requester is not workspace admin"
+// @Failure 404 {object} response.Failure404NotFoundDoc "A default board is not in the workspace"
+// @Failure 409 {object} response.Failure409ConflictDoc "Domain already added"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /workspace/{workspace_id}/email-domains [post]
+func (wh *WorkspaceHandler) AddEmailDomain(ctx *gin.Context) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := helper.ParseUUIDParams(ctx, "workspace_id")
+	if !ok {
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, "Invalid workspace id"))
+		return
+	}
+
+	var req request.AddEmailDomainRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		response.HandleValidationError(ctx, err)
+		return
+	}
+
+	out, err := wh.workspaceUseCase.AddEmailDomain(ctx.Request.Context(), workspace.AddEmailDomainInput{
+		RequesterID:     userID,
+		WorkspaceID:     workspaceID,
+		Domain:          req.Domain,
+		DefaultBoardIDs: req.DefaultBoardIDs,
+	})
+	if err != nil {
+		var validationErrs validator.ValidationErrors
+		if errors.As(err, &validationErrs) {
+			response.HandleValidationError(ctx, err)
+			return
+		}
+
+		handleWorkspaceError(ctx, err)
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Email domain added successfully", response.WorkspaceEmailDomainDTOToResponse(out.EmailDomain), http.StatusCreated)
+}
+
+// ListEmailDomains godoc
+// @Summary List a workspace's email domains
+// @Description Requires workspace admin. Includes unverified domains with their DNS TXT record.
+// @Tags workspace
+// @Produce json
+// @Security BearerAuth
+// @Param workspace_id path string true "Workspace UUID"
+// @Success 200 {object} response.WorkspaceEmailDomainListSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400BadRequestDoc "Invalid workspace id"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Missing or invalid Bearer token"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "Requester is not workspace admin"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /workspace/{workspace_id}/email-domains [get]
+func (wh *WorkspaceHandler) ListEmailDomains(ctx *gin.Context) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := helper.ParseUUIDParams(ctx, "workspace_id")
+	if !ok {
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, "Invalid workspace id"))
+		return
+	}
+
+	out, err := wh.workspaceUseCase.ListEmailDomains(ctx.Request.Context(), workspace.ListEmailDomainsInput{
+		RequesterID: userID,
+		WorkspaceID: workspaceID,
+	})
+	if err != nil {
+		handleWorkspaceError(ctx, err)
+		return
+	}
+
+	domains := make([]response.WorkspaceEmailDomainResponse, 0, len(out.EmailDomains))
+	for _, d := range out.EmailDomains {
+		domains = append(domains, response.WorkspaceEmailDomainDTOToResponse(d))
+	}
+
+	response.GenerateSuccessResponse(ctx, "Email domains retrieved successfully", domains)
+}
+
+// VerifyEmailDomain godoc
+// @Summary Verify an email domain
+// @Description Requires workspace admin. Looks up the domain's DNS TXT record and enables automatic joining once it matches. Verifying an already verified domain is a no-op.
+// @Tags workspace
+// @Produce json
+// @Security BearerAuth
+// @Param workspace_id path string true "Workspace UUID"
+// @Param domain_id path string true "Email domain UUID"
+// @Success 200 {object} response.WorkspaceEmailDomainVerifySuccessDoc "OK"
+// @Failure 400 {object} response.Failure400BadRequestDoc "Invalid ids or verification record not found"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Missing or invalid Bearer token"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "Requester is not workspace admin"
+// @Failure 404 {object} response.Failure404NotFoundDoc "Email domain not found"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /workspace/{workspace_id}/email-domains/{domain_id}/verify [post]
+func (wh *WorkspaceHandler) VerifyEmailDomain(ctx *gin.Context) {
+	input, ok := emailDomainInputFromPath(ctx)
+	if !ok {
+		return
+	}
+
+	out, err := wh.workspaceUseCase.VerifyEmailDomain(ctx.Request.Context(), input)
+	if err != nil {
+		handleWorkspaceError(ctx, err)
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Email domain verified successfully", response.WorkspaceEmailDomainDTOToResponse(out.EmailDomain))
+}
+
+// SetEmailDomainBoards godoc
+// @Summary Change an email domain's default boards
+// @Description Requires workspace admin. Replaces the boards users joining through the domain are added to; an empty list clears them. Users who already joined are not changed.
+// @Tags workspace
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param workspace_id path string true "Workspace UUID"
+// @Param domain_id path string true "Email domain UUID"
+// @Param body body request.SetEmailDomainBoardsRequest true "Default boards"
+// @Success 200 {object} response.WorkspaceEmailDomainUpdateSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400ValidationDoc "Invalid ids or body"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Missing or invalid Bearer token"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "Requester is not workspace admin"
+// @Failure 404 {object} response.Failure404NotFoundDoc "Email domain not found or a board is not in the workspace"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /workspace/{workspace_id}/email-domains/{domain_id} [patch]
+func (wh *WorkspaceHandler) SetEmailDomainBoards(ctx *gin.Context) {
+	input, ok := emailDomainInputFromPath(ctx)
+	if !ok {
+		return
+	}
+
+	var req request.SetEmailDomainBoardsRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		response.HandleValidationError(ctx, err)
+		return
+	}
+
+	out, err := wh.workspaceUseCase.SetEmailDomainBoards(ctx.Request.Context(), workspace.SetEmailDomainBoardsInput{
+		RequesterID:     input.RequesterID,
+		WorkspaceID:     input.WorkspaceID,
+		DomainID:        input.DomainID,
+		DefaultBoardIDs: req.DefaultBoardIDs,
+	})
+	if err != nil {
+		var validationErrs validator.ValidationErrors
+		if errors.As(err, &validationErrs) {
+			response.HandleValidationError(ctx, err)
+			return
+		}
+
+		handleWorkspaceError(ctx, err)
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Email domain updated successfully", response.WorkspaceEmailDomainDTOToResponse(out.EmailDomain))
+}
+
+// RemoveEmailDomain godoc
+// @Summary Remove an email domain
+// @Description Requires workspace admin. Stops automatic joining for the domain; members who already joined stay.
+// @Tags workspace
+// @Produce json
+// @Security BearerAuth
+// @Param workspace_id path string true "Workspace UUID"
+// @Param domain_id path string true "Email domain UUID"
+// @Success 200 {object} response.WorkspaceEmailDomainRemoveSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400BadRequestDoc "Invalid workspace id or domain id"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Missing or invalid Bearer token"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "Requester is not workspace admin"
+// @Failure 404 {object} response.Failure404NotFoundDoc "Email domain not found"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /workspace/{workspace_id}/email-domains/{domain_id} [delete]
+func (wh *WorkspaceHandler) RemoveEmailDomain(ctx *gin.Context) {
+	input, ok := emailDomainInputFromPath(ctx)
+	if !ok {
+		return
+	}
+
+	if err := wh.workspaceUseCase.RemoveEmailDomain(ctx.Request.Context(), input); err != nil {
+		handleWorkspaceError(ctx, err)
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Email domain removed successfully", nil)
+}
+
+// emailDomainInputFromPath reads the caller and the workspace and domain ids
+// shared by the email domain routes, writing the error response itself.
+func emailDomainInputFromPath(ctx *gin.Context) (workspace.EmailDomainInput, bool) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return workspace.EmailDomainInput{}, false
+	}
+
+	workspaceID, ok := helper.ParseUUIDParams(ctx, "workspace_id")
+	if !ok {
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, "Invalid workspace id"))
+		return workspace.EmailDomainInput{}, false
+	}
+
+	domainID, ok := helper.ParseUUIDParams(ctx, "domain_id")
+	if !ok {
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, "Invalid domain id"))
+		return workspace.EmailDomainInput{}, false
+	}
+
+	return workspace.EmailDomainInput{
+		RequesterID: userID,
+		WorkspaceID: workspaceID,
+		DomainID:    domainID,
+	}, true
+}
+
+// RemoveMember godoc
+// @Summary Remove a member from a workspace
+// @Description Requires admin or appropriate permission; cannot remove yourself (400) or the workspace owner (409).
+// @Tags workspace
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param workspace_id path string true "Workspace UUID"
+// @Param user_id path string true "Member user UUID to remove"
+// @Success 200 {object} response.WorkspaceRemoveMemberSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400BadRequestDoc "Invalid workspace id or invalid user id in path"
+// @Failure 400 {object} response.Failure400ValidationDoc "Cannot remove yourself or validation error"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Missing or invalid Bearer token"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "Not admin or user not in workspace"
+// @Failure 404 {object} response.Failure404NotFoundDoc "Member not found"
+// @Failure 409 {object} response.Failure409ConflictDoc "Cannot remove the workspace owner"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /workspace/{workspace_id}/member/remove/{user_id} [delete]
+func (wh *WorkspaceHandler) RemoveMember(ctx *gin.Context) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := helper.ParseUUIDParams(ctx, "workspace_id")
+	if !ok {
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, "Invalid workspace id"))
+		return
+	}
+
+	memberUserID, ok := helper.ParseUUIDParams(ctx, "user_id")
+	if !ok {
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, "Invalid user id"))
+		return
+	}
+
+	err := wh.workspaceUseCase.RemoveMember(ctx.Request.Context(), workspace.RemoveMemberInput{
+		RequesterID: userID,
+		WorkspaceID: workspaceID,
+		UserID:      memberUserID,
+	})
+
+	if err != nil {
+		handleWorkspaceError(ctx, err)
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Member removed successfully", nil)
+}
+
+// LeaveWorkspace godoc
+// @Summary Leave a workspace
+// @Description Removes the caller from the workspace and from every board in it. Cards assigned to the caller stay assigned unless unassign_cards is true; the body is optional. Boards the caller created go to their longest-standing owner or member who is not a guest, or to the workspace owner. The owner must transfer ownership first (409).
+// @Tags workspace
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param workspace_id path string true "Workspace UUID"
+// @Param body body request.LeaveWorkspaceRequest false "Card handling"
+// @Success 200 {object} response.WorkspaceLeaveSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400ValidationDoc "Invalid workspace id or body"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Missing or invalid Bearer token"
+// @Failure 404 {object} response.Failure404NotFoundDoc "Workspace not found or caller is not a member"
+// @Failure 409 {object} response.Failure409ConflictDoc "Caller owns the workspace"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /workspace/{workspace_id}/leave [post]
+func (wh *WorkspaceHandler) LeaveWorkspace(ctx *gin.Context) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := helper.ParseUUIDParams(ctx, "workspace_id")
+	if !ok {
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, "Invalid workspace id"))
+		return
+	}
+
+	var req request.LeaveWorkspaceRequest
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			response.HandleValidationError(ctx, err)
+			return
+		}
+	}
+
+	out, err := wh.workspaceUseCase.LeaveWorkspace(ctx.Request.Context(), workspace.LeaveWorkspaceInput{
+		UserID:        userID,
+		WorkspaceID:   workspaceID,
+		UnassignCards: req.UnassignCards,
+	})
+	if err != nil {
+		var validationErrs validator.ValidationErrors
+		if errors.As(err, &validationErrs) {
+			response.HandleValidationError(ctx, err)
+			return
+		}
+
+		handleWorkspaceError(ctx, err)
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Left workspace successfully", response.WorkspaceLeaveResponse{
+		RemovedBoardMemberships: out.RemovedBoardMemberships,
+		TransferredBoards:       out.TransferredBoards,
+		UnassignedCards:         out.UnassignedCards,
+	})
+}
+
+// UpdateMemberRole godoc
+// @Summary Change a member's workspace role
+// @Description Requires workspace admin. Promotes to ADMIN or demotes to MEMBER or GUEST. The owner cannot be demoted, the last admin cannot step down, and members who created or own boards must hand them over before becoming guests (409).
+// @Tags workspace
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param workspace_id path string true "Workspace UUID"
+// @Param user_id path string true "Member user UUID"
+// @Param body body request.UpdateMemberRoleRequest true "New role"
+// @Success 200 {object} response.WorkspaceUpdateMemberRoleSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400ValidationDoc "Invalid ids or role"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Missing or invalid Bearer token"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "Requester is not workspace admin"
+// @Failure 404 {object} response.Failure404NotFoundDoc "Workspace or member not found"
+// @Failure 409 {object} response.Failure409ConflictDoc "Owner or last admin cannot be demoted, or the member still owns boards"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /workspace/{workspace_id}/member/{user_id} [patch]
+func (wh *WorkspaceHandler) UpdateMemberRole(ctx *gin.Context) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := helper.ParseUUIDParams(ctx, "workspace_id")
+	if !ok {
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, "Invalid workspace id"))
+		return
+	}
+
+	memberUserID, ok := helper.ParseUUIDParams(ctx, "user_id")
+	if !ok {
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, "Invalid user id"))
+		return
+	}
+
+	var req request.UpdateMemberRoleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		response.HandleValidationError(ctx, err)
+		return
+	}
+
+	out, err := wh.workspaceUseCase.UpdateMemberRole(ctx.Request.Context(), workspace.UpdateMemberRoleInput{
+		RequesterID: userID,
+		WorkspaceID: workspaceID,
+		UserID:      memberUserID,
+		Role:        entity.WorkspaceRole(req.Role),
+	})
+	if err != nil {
+		var validationErrs validator.ValidationErrors
+		if errors.As(err, &validationErrs) {
+			response.HandleValidationError(ctx, err)
+			return
+		}
+
+		handleWorkspaceError(ctx, err)
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Member role updated successfully", response.WorkspaceMemberDTOToResponse(out.Member))
+}
+
+// TransferOwnership godoc
+// @Summary Transfer workspace ownership
+// @Description Only the current owner may transfer. The new owner must already be a member and is made an admin; the previous owner stays an admin.
+// @Tags workspace
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param workspace_id path string true "Workspace UUID"
+// @Param body body request.TransferOwnershipRequest true "New owner"
+// @Success 200 {object} response.WorkspaceTransferOwnershipSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400ValidationDoc "Invalid workspace id, body, or already the owner"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Missing or invalid Bearer token"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "Requester is not the workspace owner"
+// @Failure 404 {object} response.Failure404NotFoundDoc "Workspace not found or new owner is not a member"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /workspace/{workspace_id}/transfer-ownership [post]
+func (wh *WorkspaceHandler) TransferOwnership(ctx *gin.Context) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := helper.ParseUUIDParams(ctx, "workspace_id")
+	if !ok {
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, "Invalid workspace id"))
+		return
+	}
+
+	var req request.TransferOwnershipRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		response.HandleValidationError(ctx, err)
+		return
+	}
+
+	out, err := wh.workspaceUseCase.TransferOwnership(ctx.Request.Context(), workspace.TransferOwnershipInput{
+		RequesterID: userID,
+		WorkspaceID: workspaceID,
+		NewOwnerID:  req.UserID,
+	})
+	if err != nil {
+		var validationErrs validator.ValidationErrors
+		if errors.As(err, &validationErrs) {
+			response.HandleValidationError(ctx, err)
+			return
+		}
+
+		handleWorkspaceError(ctx, err)
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Workspace ownership transferred successfully", response.WorkspaceDTOToResponse(out.Workspace))
+}
+
+// GetWorkspaceDetail godoc
+// @Summary Get workspace detail including members
+// @Description Returns workspace metadata, current user role, and member list. Guests only see their own membership.
+// @Tags workspace
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param workspace_id path string true "Workspace UUID"
+// @Success 200 {object} response.WorkspaceDetailSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400BadRequestDoc "Invalid workspace id in path"
+// @Failure 400 {object} response.Failure400ValidationDoc "Validation error"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Missing or invalid Bearer token"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "User not in workspace"
+// @Failure 404 {object} response.Failure404NotFoundDoc "Workspace or user not found"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /workspace/{workspace_id} [get]
+func (wh *WorkspaceHandler) GetWorkspaceDetail(ctx *gin.Context) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := helper.ParseUUIDParams(ctx, "workspace_id")
+	if !ok {
+		response.GenerateErrorResponse(
+			ctx,
+			apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, "Invalid workspace id"),
+		)
+		return
+	}
+
+	input := workspace.GetWorkspaceDetailInput{
+		RequesterID: userID,
+		WorkspaceID: workspaceID,
+	}
+
+	out, err := wh.workspaceUseCase.GetWorkspaceDetail(
+		ctx.Request.Context(),
+		input,
+	)
+	if err != nil {
+		var validationErrs validator.ValidationErrors
+		if errors.As(err, &validationErrs) {
+			response.HandleValidationError(ctx, err)
+			return
+		}
+
+		handleWorkspaceError(ctx, err)
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Workspace detail retrieved successfully", response.WorkspaceDetailDTOToResponse(out.Workspace))
+}
+
+// ListMembers godoc
+// @Summary List workspace members
+// @Description Pages through the member directory. search matches a name or email prefix; results are sorted by sort (name, email or joined_at) and order, then by user id. Pass next_cursor back as cursor, with the same sort and order, to get the next page. Guests cannot list members.
+// @Tags workspace
+// @Produce json
+// @Security BearerAuth
+// @Param workspace_id path string true "Workspace UUID"
+// @Param search query string false "Name or email prefix"
+// @Param role query string false "Only members with this role (ADMIN, MEMBER or GUEST)"
+// @Param sort query string false "name (default), email or joined_at"
+// @Param order query string false "asc (default) or desc"
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param limit query int false "Page size (1-100, default 20)"
+// @Success 200 {object} response.WorkspaceMemberListSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400ValidationDoc "Invalid workspace id, query or cursor"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Missing or invalid Bearer token"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "User not in workspace or a guest"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /workspace/{workspace_id}/members [get]
+func (wh *WorkspaceHandler) ListMembers(ctx *gin.Context) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := helper.ParseUUIDParams(ctx, "workspace_id")
+	if !ok {
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, "Invalid workspace id"))
+		return
+	}
+
+	var req request.ListWorkspaceMembersRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		response.HandleValidationError(ctx, err)
+		return
+	}
+
+	out, err := wh.workspaceUseCase.ListMembers(ctx.Request.Context(), workspace.ListMembersInput{
+		RequesterID: userID,
+		WorkspaceID: workspaceID,
+		Search:      req.Search,
+		Role:        entity.WorkspaceRole(req.Role),
+		Sort:        req.Sort,
+		Order:       req.Order,
+		Cursor:      req.Cursor,
+		Limit:       req.Limit,
+	})
+	if err != nil {
+		var validationErrs validator.ValidationErrors
+		if errors.As(err, &validationErrs) {
+			response.HandleValidationError(ctx, err)
+			return
+		}
+
+		handleWorkspaceError(ctx, err)
+		return
+	}
+
+	members := make([]response.WorkspaceMemberResponse, 0, len(out.Members))
+	for _, member := range out.Members {
+		members = append(members, response.WorkspaceMemberDTOToResponse(member))
+	}
+
+	var nextCursor *string
+	if out.NextCursor != "" {
+		nextCursor = &out.NextCursor
+	}
+
+	response.GenerateSuccessResponse(ctx, "Workspace members retrieved successfully", response.WorkspaceMemberPageResponse{
+		Members:    members,
+		NextCursor: nextCursor,
+	})
+}
+
+// CreateGroup godoc
+// @Summary Create a workspace group
+// @Description Requires workspace admin. Groups are named sets of members that can be invited to a board in one go. Names are unique per workspace, ignoring case.
+// @Tags workspace
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param workspace_id path string true "Workspace UUID"
+// @Param body body request.CreateGroupRequest true "Group name and description"
+// @Success 201 {object} response.WorkspaceGroupCreateSuccessDoc "Created"
+// @Failure 400 {object} response.Failure400ValidationDoc "Invalid workspace id or body"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Missing or invalid Bearer token"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "Requester is not workspace admin"
+// @Failure 409 {object} response.Failure409ConflictDoc "Group name already used"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /workspace/{workspace_id}/groups [post]
+func (wh *WorkspaceHandler) CreateGroup(ctx *gin.Context) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := helper.ParseUUIDParams(ctx, "workspace_id")
+	if !ok {
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, "Invalid workspace id"))
+		return
+	}
+
+	var req request.CreateGroupRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		response.HandleValidationError(ctx, err)
+		return
+	}
+
+	out, err := wh.workspaceUseCase.CreateGroup(ctx.Request.Context(), workspace.CreateGroupInput{
+		RequesterID: userID,
+		WorkspaceID: workspaceID,
+		Name:        req.Name,
+		Description: req.Description,
+	})
+	if err != nil {
+		var validationErrs validator.ValidationErrors
+		if errors.As(err, &validationErrs) {
+			response.HandleValidationError(ctx, err)
+			return
+		}
+
+		handleWorkspaceError(ctx, err)
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Group created successfully", response.WorkspaceGroupDetailDTOToResponse(out.Group), http.StatusCreated)
+}
+
+// ListGroups godoc
+// @Summary List workspace groups
+// @Description Any member except guests can list groups.
+// @Tags workspace
+// @Produce json
+// @Security BearerAuth
+// @Param workspace_id path string true "Workspace UUID"
+// @Success 200 {object} response.WorkspaceGroupListSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400BadRequestDoc "Invalid workspace id"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Missing or invalid Bearer token"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "User not in workspace or a guest"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /workspace/{workspace_id}/groups [get]
+func (wh *WorkspaceHandler) ListGroups(ctx *gin.Context) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := helper.ParseUUIDParams(ctx, "workspace_id")
+	if !ok {
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, "Invalid workspace id"))
+		return
+	}
+
+	out, err := wh.workspaceUseCase.ListGroups(ctx.Request.Context(), workspace.ListGroupsInput{
+		RequesterID: userID,
+		WorkspaceID: workspaceID,
+	})
+	if err != nil {
+		handleWorkspaceError(ctx, err)
+		return
+	}
+
+	groups := make([]response.WorkspaceGroupResponse, 0, len(out.Groups))
+	for _, group := range out.Groups {
+		groups = append(groups, response.WorkspaceGroupDTOToResponse(group))
+	}
+
+	response.GenerateSuccessResponse(ctx, "Groups retrieved successfully", groups)
+}
+
+// GetGroup godoc
+// @Summary Get a workspace group with its members
+// @Description Any member except guests can view groups.
+// @Tags workspace
+// @Produce json
+// @Security BearerAuth
+// @Param workspace_id path string true "Workspace UUID"
+// @Param group_id path string true "Group UUID"
+// @Success 200 {object} response.WorkspaceGroupDetailSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400BadRequestDoc "Invalid workspace id or group id"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Missing or invalid Bearer token"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "User not in workspace or a guest"
+// @Failure 404 {object} response.Failure404NotFoundDoc "Group not found"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /workspace/{workspace_id}/groups/{group_id} [get]
+func (wh *WorkspaceHandler) GetGroup(ctx *gin.Context) {
+	input, ok := groupInputFromPath(ctx)
+	if !ok {
+		return
+	}
+
+	out, err := wh.workspaceUseCase.GetGroup(ctx.Request.Context(), input)
+	if err != nil {
+		handleWorkspaceError(ctx, err)
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Group retrieved successfully", response.WorkspaceGroupDetailDTOToResponse(out.Group))
+}
+
+// UpdateGroup godoc
+// @Summary Rename a group or change its description
+// @Description Requires workspace admin. Only provided fields change; sending description as null or empty clears it. At least one field is required.
+// @Tags workspace
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param workspace_id path string true "Workspace UUID"
+// @Param group_id path string true "Group UUID"
+// @Param body body request.UpdateGroupRequest true "Partial update"
+// @Success 200 {object} response.WorkspaceGroupUpdateSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400ValidationDoc "Invalid ids, validation error, or no field provided"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Missing or invalid Bearer token"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "Requester is not workspace admin"
+// @Failure 404 {object} response.Failure404NotFoundDoc "Group not found"
+// @Failure 409 {object} response.Failure409ConflictDoc "Group name already used"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /workspace/{workspace_id}/groups/{group_id} [patch]
+func (wh *WorkspaceHandler) UpdateGroup(ctx *gin.Context) {
+	path, ok := groupInputFromPath(ctx)
+	if !ok {
+		return
+	}
+
+	var req request.UpdateGroupRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		response.HandleValidationError(ctx, err)
+		return
+	}
+
+	input := workspace.UpdateGroupInput{
+		RequesterID: path.RequesterID,
+		WorkspaceID: path.WorkspaceID,
+		GroupID:     path.GroupID,
+		Name:        req.Name,
+	}
+	if req.Description.Present {
+		input.DescriptionPresent = true
+		input.Description = req.Description.Value
+	}
+
+	out, err := wh.workspaceUseCase.UpdateGroup(ctx.Request.Context(), input)
+	if err != nil {
+		var validationErrs validator.ValidationErrors
+		if errors.As(err, &validationErrs) {
+			response.HandleValidationError(ctx, err)
+			return
+		}
+
+		handleWorkspaceError(ctx, err)
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Group updated successfully", response.WorkspaceGroupDetailDTOToResponse(out.Group))
+}
+
+// DeleteGroup godoc
+// @Summary Delete a group
+// @Description Requires workspace admin. Board memberships the group granted are kept; boards stop following the group.
+// @Tags workspace
+// @Produce json
+// @Security BearerAuth
+// @Param workspace_id path string true "Workspace UUID"
+// @Param group_id path string true "Group UUID"
+// @Success 200 {object} response.WorkspaceGroupDeleteSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400BadRequestDoc "Invalid workspace id or group id"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Missing or invalid Bearer token"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "Requester is not workspace admin"
+// @Failure 404 {object} response.Failure404NotFoundDoc "Group not found"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /workspace/{workspace_id}/groups/{group_id} [delete]
+func (wh *WorkspaceHandler) DeleteGroup(ctx *gin.Context) {
+	input, ok := groupInputFromPath(ctx)
+	if !ok {
+		return
+	}
+
+	if err := wh.workspaceUseCase.DeleteGroup(ctx.Request.Context(), input); err != nil {
+		handleWorkspaceError(ctx, err)
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Group deleted successfully", nil)
+}
+
+// AddGroupMembers godoc
+// @Summary Add members to a group
+// @Description Requires workspace admin. Users must be workspace members; those already in the group are skipped. New members are also added to every board synced with the group.
+// @Tags workspace
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param workspace_id path string true "Workspace UUID"
+// @Param group_id path string true "Group UUID"
+// @Param body body request.AddGroupMembersRequest true "User IDs to add"
+// @Success 200 {object} response.WorkspaceGroupAddMembersSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400ValidationDoc "Invalid ids or body"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Missing or invalid Bearer token"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "Requester is not workspace admin or a user is not in the workspace"
+// @Failure 404 {object} response.Failure404NotFoundDoc "Group not found"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /workspace/{workspace_id}/groups/{group_id}/members [post]
+func (wh *WorkspaceHandler) AddGroupMembers(ctx *gin.Context) {
+	path, ok := groupInputFromPath(ctx)
+	if !ok {
+		return
+	}
+
+	var req request.AddGroupMembersRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		response.HandleValidationError(ctx, err)
+		return
+	}
+
+	out, err := wh.workspaceUseCase.AddGroupMembers(ctx.Request.Context(), workspace.AddGroupMembersInput{
+		RequesterID: path.RequesterID,
+		WorkspaceID: path.WorkspaceID,
+		GroupID:     path.GroupID,
+		UserIDs:     req.UserIDs,
+	})
+	if err != nil {
+		var validationErrs validator.ValidationErrors
+		if errors.As(err, &validationErrs) {
+			response.HandleValidationError(ctx, err)
+			return
+		}
+
+		handleWorkspaceError(ctx, err)
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Group members added successfully", response.WorkspaceGroupDetailDTOToResponse(out.Group))
+}
+
+// RemoveGroupMember godoc
+// @Summary Remove a member from a group
+// @Description Requires workspace admin. The user also leaves the boards synced with the group that they joined through a group, unless another group synced with the board still includes them.
+// @Tags workspace
+// @Produce json
+// @Security BearerAuth
+// @Param workspace_id path string true "Workspace UUID"
+// @Param group_id path string true "Group UUID"
+// @Param user_id path string true "User UUID"
+// @Success 200 {object} response.WorkspaceGroupRemoveMemberSuccessDoc "OK"
+// @Failure 400 {object} response.Failure400BadRequestDoc "Invalid ids"
+// @Failure 401 {object} response.Failure401UnauthorizedDoc "Missing or invalid Bearer token"
+// @Failure 403 {object} response.Failure403ForbiddenDoc "Requester is not workspace admin"
+// @Failure 404 {object} response.Failure404NotFoundDoc "Group not found or user not in group"
+// @Failure 500 {object} response.Failure500InternalDoc "Internal server error"
+// @Router /workspace/{workspace_id}/groups/{group_id}/members/{user_id} [delete]
+func (wh *WorkspaceHandler) RemoveGroupMember(ctx *gin.Context) {
+	path, ok := groupInputFromPath(ctx)
+	if !ok {
+		return
+	}
+
+	memberID, ok := helper.ParseUUIDParams(ctx, "user_id")
+	if !ok {
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, "Invalid user id"))
+		return
+	}
+
+	err := wh.workspaceUseCase.RemoveGroupMember(ctx.Request.Context(), workspace.RemoveGroupMemberInput{
+		RequesterID: path.RequesterID,
+		WorkspaceID: path.WorkspaceID,
+		GroupID:     path.GroupID,
+		UserID:      memberID,
+	})
+	if err != nil {
+		handleWorkspaceError(ctx, err)
+		return
+	}
+
+	response.GenerateSuccessResponse(ctx, "Group member removed successfully", nil)
+}
+
+// groupInputFromPath reads the caller and the workspace and group ids shared
+// by the group routes, writing the error response itself.
+func groupInputFromPath(ctx *gin.Context) (workspace.GroupInput, bool) {
+	userID, ok := helper.GetAndCheckUserID(ctx)
+	if !ok {
+		return workspace.GroupInput{}, false
+	}
+
+	workspaceID, ok := helper.ParseUUIDParams(ctx, "workspace_id")
+	if !ok {
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, "Invalid workspace id"))
+		return workspace.GroupInput{}, false
+	}
+
+	groupID, ok := helper.ParseUUIDParams(ctx, "group_id")
+	if !ok {
+		response.GenerateErrorResponse(ctx, apperrors.NewAppError(http.StatusBadRequest, apperrors.ErrCodeValidation, "Invalid group id"))
+		return workspace.GroupInput{}, false
+	}
+
+	return workspace.GroupInput{
+		RequesterID: userID,
+		WorkspaceID: workspaceID,
+		GroupID:     groupID,
+	}, true
 }
@@ -1,21 +1,32 @@
 package middleware
 
 import (
+	"errors"
 	"net/http"
 	"strings"
 
 	apperrors "collabotask/internal/adapter/http/errors"
 	"collabotask/internal/adapter/http/response"
-	"collabotask/internal/config"
+	"collabotask/internal/domain"
+	"collabotask/internal/domain/entity"
 	infraauth "collabotask/internal/infrastructure/auth"
+	"collabotask/internal/usecase/common"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 )
 
-const ContextUserIDKey = "userID"
+const (
+	ContextUserIDKey      = "userID"
+	ContextTokenClaimsKey = "tokenClaims"
+	ContextAccessTokenKey = "accessToken"
+)
 
-func Auth(cfg *config.AuthConfig) gin.HandlerFunc {
+func Auth(
+	jwtKeys *infraauth.JWTKeySet,
+	revocationStore common.TokenRevocationStore,
+	accessTokenAuthenticator common.AccessTokenAuthenticator,
+) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 
@@ -33,18 +44,98 @@ func Auth(cfg *config.AuthConfig) gin.HandlerFunc {
 		}
 
 		tokenString := strings.TrimPrefix(authHeader, prefix)
-		claims, err := infraauth.ValidateToken(cfg, tokenString)
+		if infraauth.IsPersonalAccessToken(tokenString) {
+			authenticateAccessToken(c, accessTokenAuthenticator, tokenString)
+			return
+		}
+
+		claims, err := infraauth.ValidateToken(jwtKeys, tokenString)
 		if err != nil {
 			response.GenerateErrorResponse(c, apperrors.NewAppError(http.StatusUnauthorized, apperrors.ErrCodeUnauthorized, "Invalid or expired token"))
 			c.Abort()
 			return
 		}
 
+		issuedAt := claims.IssuedAt
+		if issuedAt == nil {
+			response.GenerateErrorResponse(c, apperrors.NewAppError(http.StatusUnauthorized, apperrors.ErrCodeUnauthorized, "Invalid or expired token"))
+			c.Abort()
+			return
+		}
+
+		revoked, err := revocationStore.IsRevoked(c.Request.Context(), claims.ID, claims.UserID, issuedAt.Time)
+		if err != nil && !errors.Is(err, domain.ErrUserNotFound) {
+			response.GenerateErrorResponse(c, apperrors.NewAppError(http.StatusInternalServerError, apperrors.ErrCodeInternal, "Failed to verify token"))
+			c.Abort()
+			return
+		}
+		if revoked || err != nil {
+			response.GenerateErrorResponse(c, apperrors.NewAppError(http.StatusUnauthorized, apperrors.ErrCodeUnauthorized, "Invalid or expired token"))
+			c.Abort()
+			return
+		}
+
+		if claims.SessionID != uuid.Nil {
+			sessionRevoked, err := revocationStore.IsSessionRevoked(c.Request.Context(), claims.SessionID)
+			if err != nil {
+				response.GenerateErrorResponse(c, apperrors.NewAppError(http.StatusInternalServerError, apperrors.ErrCodeInternal, "Failed to verify token"))
+				c.Abort()
+				return
+			}
+			if sessionRevoked {
+				response.GenerateErrorResponse(c, apperrors.NewAppError(http.StatusUnauthorized, apperrors.ErrCodeUnauthorized, "Invalid or expired token"))
+				c.Abort()
+				return
+			}
+		}
+
 		c.Set(ContextUserIDKey, claims.UserID)
+		c.Set(ContextTokenClaimsKey, claims)
+		c.Next()
+	}
+}
+
+func authenticateAccessToken(c *gin.Context, authenticator common.AccessTokenAuthenticator, tokenString string) {
+	token, err := authenticator.Authenticate(c.Request.Context(), tokenString)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidAccessToken) {
+			response.GenerateErrorResponse(c, apperrors.NewAppError(http.StatusUnauthorized, apperrors.ErrCodeUnauthorized, "Invalid or expired token"))
+		} else {
+			response.GenerateErrorResponse(c, apperrors.NewAppError(http.StatusInternalServerError, apperrors.ErrCodeInternal, "Failed to verify token"))
+		}
+		c.Abort()
+		return
+	}
+
+	if token.IsReadOnly() && !isSafeMethod(c.Request.Method) {
+		response.GenerateErrorResponse(c, apperrors.NewAppError(http.StatusForbidden, apperrors.ErrCodeForbidden, "Access token is read-only"))
+		c.Abort()
+		return
+	}
+
+	c.Set(ContextUserIDKey, token.UserID)
+	c.Set(ContextAccessTokenKey, token)
+	c.Next()
+}
+
+// RequireSession rejects requests authenticated with a personal access token.
+// It guards account management endpoints that need an interactive login.
+func RequireSession() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, ok := GetAccessToken(c); ok {
+			response.GenerateErrorResponse(c, apperrors.NewAppError(http.StatusForbidden, apperrors.ErrCodeForbidden, "Not allowed with a personal access token"))
+			c.Abort()
+			return
+		}
+
 		c.Next()
 	}
 }
 
+func isSafeMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
+
 func GetUserID(c *gin.Context) (uuid.UUID, bool) {
 	v, exists := c.Get(ContextUserIDKey)
 	if !exists {
@@ -54,3 +145,23 @@ func GetUserID(c *gin.Context) (uuid.UUID, bool) {
 	userID, ok := v.(uuid.UUID)
 	return userID, ok
 }
+
+func GetTokenClaims(c *gin.Context) (*infraauth.TokenClaims, bool) {
+	v, exists := c.Get(ContextTokenClaimsKey)
+	if !exists {
+		return nil, false
+	}
+
+	claims, ok := v.(*infraauth.TokenClaims)
+	return claims, ok
+}
+
+func GetAccessToken(c *gin.Context) (*entity.PersonalAccessToken, bool) {
+	v, exists := c.Get(ContextAccessTokenKey)
+	if !exists {
+		return nil, false
+	}
+
+	token, ok := v.(*entity.PersonalAccessToken)
+	return token, ok
+}
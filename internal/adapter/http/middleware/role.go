@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"net/http"
+
+	apperrors "collabotask/internal/adapter/http/errors"
+	"collabotask/internal/adapter/http/response"
+	"collabotask/internal/domain/entity"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequireSystemRole only lets requests through whose token carries one of
+// the given roles. It must run after Auth.
+func RequireSystemRole(roles ...entity.SystemRole) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		claims, ok := GetTokenClaims(c)
+		if !ok {
+			response.GenerateErrorResponse(c, apperrors.NewAppError(http.StatusUnauthorized, apperrors.ErrCodeUnauthorized, "Unauthorized"))
+			c.Abort()
+			return
+		}
+
+		for _, role := range roles {
+			if claims.Role == string(role) {
+				c.Next()
+				return
+			}
+		}
+
+		response.GenerateErrorResponse(c, apperrors.NewAppError(http.StatusForbidden, apperrors.ErrCodeForbidden, "Insufficient role"))
+		c.Abort()
+	}
+}
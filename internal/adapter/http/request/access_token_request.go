@@ -0,0 +1,7 @@
+package request
+
+type CreateAccessTokenRequest struct {
+	Name          string `json:"name" binding:"required,min=1,max=100"`
+	Scope         string `json:"scope" binding:"required,oneof=read-only read-write"`
+	ExpiresInDays *int   `json:"expires_in_days" binding:"omitempty,min=1,max=365"`
+}
@@ -0,0 +1,13 @@
+package request
+
+type AdminListUsersRequest struct {
+	Search string `form:"search" binding:"max=255"`
+	Limit  int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset int    `form:"offset" binding:"omitempty,min=0"`
+}
+
+type AdminListWorkspacesRequest struct {
+	Search string `form:"search" binding:"max=255"`
+	Limit  int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset int    `form:"offset" binding:"omitempty,min=0"`
+}
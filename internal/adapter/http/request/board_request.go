@@ -15,7 +15,14 @@ type UpdateBoardRequest struct {
 }
 
 type InviteMemberBoardRequest struct {
-	UserIDs []uuid.UUID `json:"user_ids" binding:"required,min=1,dive"`
+	UserIDs []uuid.UUID `json:"user_ids" binding:"required_without=GroupID,excluded_with=GroupID,omitempty,min=1,dive"`
+	GroupID *uuid.UUID  `json:"group_id"`
+	// SyncGroup keeps the board's membership following the group.
+	SyncGroup bool `json:"sync_group"`
+}
+
+type TransferBoardOwnershipRequest struct {
+	UserID uuid.UUID `json:"user_id" binding:"required"`
 }
 
 type RemoveMemberBoardRequest struct {
@@ -0,0 +1,11 @@
+package request
+
+type ConfirmTOTPRequest struct {
+	Code string `json:"code" binding:"required,len=6,numeric"`
+}
+
+// Code is a TOTP or recovery code for accounts without a password.
+type MFAPasswordRequest struct {
+	Password string `json:"password" binding:"required_without=Code"`
+	Code     string `json:"code"`
+}
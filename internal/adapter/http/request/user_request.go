@@ -0,0 +1,17 @@
+package request
+
+type UpdateProfileRequest struct {
+	Name      *string               `json:"name" binding:"omitempty,min=1,max=255"`
+	AvatarURL OptionalPatch[string] `json:"avatar_url"`
+}
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password"`
+	NewPassword     string `json:"new_password" binding:"required,min=8"`
+}
+
+// Code is a TOTP or recovery code for accounts without a password.
+type DeleteAccountRequest struct {
+	Password string `json:"password" binding:"required_without=Code"`
+	Code     string `json:"code"`
+}
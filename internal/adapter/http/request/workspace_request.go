@@ -1,10 +1,72 @@
 package request
 
+import "github.com/google/uuid"
+
 type CreateWorkspaceRequest struct {
 	Name        string  `json:"name" binding:"required,min=2,max=255"`
 	Description *string `json:"description"`
 }
 
+type UpdateWorkspaceRequest struct {
+	Name        *string               `json:"name" binding:"omitempty,min=2,max=255"`
+	Description OptionalPatch[string] `json:"description"`
+}
+
+type DeleteWorkspaceRequest struct {
+	ConfirmName string `json:"confirm_name" binding:"required"`
+}
+
+type LeaveWorkspaceRequest struct {
+	UnassignCards bool `json:"unassign_cards"`
+}
+
+type ListWorkspaceMembersRequest struct {
+	Search string `form:"search" binding:"max=255"`
+	Role   string `form:"role" binding:"omitempty,oneof=ADMIN MEMBER GUEST"`
+	Sort   string `form:"sort" binding:"omitempty,oneof=name email joined_at"`
+	Order  string `form:"order" binding:"omitempty,oneof=asc desc"`
+	Cursor string `form:"cursor" binding:"max=512"`
+	Limit  int    `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
 type InviteMemberRequest struct {
-	Emails []string `json:"emails" binding:"required,min=1,dive,email"`
+	Emails []string `json:"emails" binding:"required,min=1,max=100"`
+	Role   string   `json:"role" binding:"omitempty,oneof=ADMIN MEMBER GUEST"`
+}
+
+type CreateJoinLinkRequest struct {
+	Role           string `json:"role" binding:"omitempty,oneof=ADMIN MEMBER GUEST"`
+	MaxUses        *int   `json:"max_uses" binding:"omitempty,min=1,max=10000"`
+	ExpiresInHours *int   `json:"expires_in_hours" binding:"omitempty,min=1,max=720"`
+}
+
+type AddEmailDomainRequest struct {
+	Domain          string      `json:"domain" binding:"required,max=253"`
+	DefaultBoardIDs []uuid.UUID `json:"default_board_ids" binding:"omitempty,max=50"`
+}
+
+type SetEmailDomainBoardsRequest struct {
+	DefaultBoardIDs []uuid.UUID `json:"default_board_ids" binding:"max=50"`
+}
+
+type CreateGroupRequest struct {
+	Name        string  `json:"name" binding:"required,min=1,max=100"`
+	Description *string `json:"description" binding:"omitempty,max=1000"`
+}
+
+type UpdateGroupRequest struct {
+	Name        *string               `json:"name" binding:"omitempty,min=1,max=100"`
+	Description OptionalPatch[string] `json:"description"`
+}
+
+type AddGroupMembersRequest struct {
+	UserIDs []uuid.UUID `json:"user_ids" binding:"required,min=1,max=100"`
+}
+
+type UpdateMemberRoleRequest struct {
+	Role string `json:"role" binding:"required,oneof=ADMIN MEMBER GUEST"`
+}
+
+type TransferOwnershipRequest struct {
+	UserID uuid.UUID `json:"user_id" binding:"required"`
 }
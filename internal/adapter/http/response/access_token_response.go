@@ -0,0 +1,38 @@
+package response
+
+import (
+	"collabotask/internal/domain/entity"
+	"collabotask/internal/dto"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AccessTokenResponse struct {
+	ID          uuid.UUID               `json:"id"`
+	Name        string                  `json:"name"`
+	TokenPrefix string                  `json:"token_prefix"`
+	Scope       entity.AccessTokenScope `json:"scope"`
+	ExpiresAt   *time.Time              `json:"expires_at"`
+	LastUsedAt  *time.Time              `json:"last_used_at"`
+	CreatedAt   time.Time               `json:"created_at"`
+}
+
+type CreatedAccessTokenResponse struct {
+	AccessTokenResponse
+
+	// Token is only ever returned once, when the token is created.
+	Token string `json:"token"`
+}
+
+func AccessTokenDTOToResponse(d dto.AccessTokenDTO) AccessTokenResponse {
+	return AccessTokenResponse{
+		ID:          d.ID,
+		Name:        d.Name,
+		TokenPrefix: d.TokenPrefix,
+		Scope:       d.Scope,
+		ExpiresAt:   d.ExpiresAt,
+		LastUsedAt:  d.LastUsedAt,
+		CreatedAt:   d.CreatedAt,
+	}
+}
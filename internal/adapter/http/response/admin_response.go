@@ -0,0 +1,52 @@
+package response
+
+import (
+	"collabotask/internal/dto"
+	"time"
+)
+
+type AdminUserResponse struct {
+	UserResponse
+
+	SuspendedAt           *time.Time `json:"suspended_at"`
+	PasswordResetRequired bool       `json:"password_reset_required"`
+	CreatedAt             time.Time  `json:"created_at"`
+}
+
+type AdminUserListResponse struct {
+	Users  []AdminUserResponse `json:"users"`
+	Total  int                 `json:"total"`
+	Limit  int                 `json:"limit"`
+	Offset int                 `json:"offset"`
+}
+
+type WorkspaceSummaryResponse struct {
+	WorkspaceResponse
+
+	MemberCount uint `json:"member_count"`
+	BoardCount  uint `json:"board_count"`
+}
+
+type AdminWorkspaceListResponse struct {
+	Workspaces []WorkspaceSummaryResponse `json:"workspaces"`
+	Total      int                        `json:"total"`
+	Limit      int                        `json:"limit"`
+	Offset     int                        `json:"offset"`
+}
+
+func AdminUserDTOToResponse(d dto.AdminUserDTO) AdminUserResponse {
+	return AdminUserResponse{
+		UserResponse:          UserDTOToResponse(d.UserDTO),
+		SuspendedAt:           d.SuspendedAt,
+		PasswordResetRequired: d.PasswordResetRequired,
+		CreatedAt:             d.CreatedAt,
+	}
+}
+
+func WorkspaceSummaryDTOToResponse(d dto.WorkspaceSummaryDTO) WorkspaceSummaryResponse {
+	return WorkspaceSummaryResponse{
+		WorkspaceResponse: WorkspaceDTOToResponse(d.WorkspaceDTO),
+		MemberCount:       d.MemberCount,
+		BoardCount:        d.BoardCount,
+	}
+}
@@ -7,24 +7,32 @@ import (
 )
 
 type UserResponse struct {
-	ID         uuid.UUID `json:"id"`
-	Email      string    `json:"email"`
-	Name       string    `json:"name"`
-	AvatarURL  *string   `json:"avatar_url,omitempty"`
-	SystemRole string    `json:"system_role"`
+	ID            uuid.UUID `json:"id"`
+	Email         string    `json:"email"`
+	Name          string    `json:"name"`
+	AvatarURL     *string   `json:"avatar_url,omitempty"`
+	SystemRole    string    `json:"system_role"`
+	EmailVerified bool      `json:"email_verified"`
 }
 
 type AuthResponse struct {
-	User  UserResponse `json:"user"`
-	Token string       `json:"token"`
+	User         UserResponse `json:"user"`
+	Token        string       `json:"token,omitempty"`
+	RefreshToken string       `json:"refresh_token,omitempty"`
+}
+
+type TokenResponse struct {
+	Token        string `json:"token"`
+	RefreshToken string `json:"refresh_token"`
 }
 
 func UserDTOToResponse(u dto.UserDTO) UserResponse {
 	return UserResponse{
-		ID:         u.ID,
-		Email:      u.Email,
-		Name:       u.Name,
-		AvatarURL:  u.AvatarURL,
-		SystemRole: u.SystemRole,
+		ID:            u.ID,
+		Email:         u.Email,
+		Name:          u.Name,
+		AvatarURL:     u.AvatarURL,
+		SystemRole:    u.SystemRole,
+		EmailVerified: u.EmailVerified,
 	}
 }
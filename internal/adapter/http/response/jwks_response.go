@@ -0,0 +1,9 @@
+package response
+
+import infraauth "collabotask/internal/infrastructure/auth"
+
+// JWKSResponse follows RFC 7517 and is served without the usual envelope so
+// standard JWT libraries can consume it directly.
+type JWKSResponse struct {
+	Keys []infraauth.JWK `json:"keys"`
+}
@@ -0,0 +1,28 @@
+package response
+
+import (
+	"collabotask/internal/dto"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SessionResponse struct {
+	ID         uuid.UUID `json:"id"`
+	UserAgent  string    `json:"user_agent"`
+	IPAddress  string    `json:"ip_address"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	Current    bool      `json:"current"`
+}
+
+func SessionDTOToResponse(d dto.SessionDTO) SessionResponse {
+	return SessionResponse{
+		ID:         d.ID,
+		UserAgent:  d.UserAgent,
+		IPAddress:  d.IPAddress,
+		CreatedAt:  d.CreatedAt,
+		LastSeenAt: d.LastSeenAt,
+		Current:    d.Current,
+	}
+}
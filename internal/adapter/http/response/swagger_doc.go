@@ -45,6 +45,16 @@ type Failure401LoginDoc struct {
 	} `json:"error"`
 }
 
+type Failure401RefreshDoc struct {
+	failureDocBase
+	StatusCode int    `json:"status_code" example:"401"`
+	Message    string `json:"message" example:"invalid or expired refresh token"`
+	Error      *struct {
+		Code    string `json:"code" example:"UNAUTHORIZED"`
+		Message string `json:"message" example:"invalid or expired refresh token"`
+	} `json:"error"`
+}
+
 type Failure403ForbiddenDoc struct {
 	failureDocBase
 	StatusCode int    `json:"status_code" example:"403"`
@@ -75,6 +85,36 @@ type Failure409ConflictDoc struct {
 	} `json:"error"`
 }
 
+type Failure413PayloadTooLargeDoc struct {
+	failureDocBase
+	StatusCode int    `json:"status_code" example:"413"`
+	Message    string `json:"message" example:"avatar file is too large"`
+	Error      *struct {
+		Code    string `json:"code" example:"VALIDATION_ERROR"`
+		Message string `json:"message" example:"avatar file is too large"`
+	} `json:"error"`
+}
+
+type Failure415UnsupportedMediaTypeDoc struct {
+	failureDocBase
+	StatusCode int    `json:"status_code" example:"415"`
+	Message    string `json:"message" example:"avatar must be a PNG, JPEG or GIF image"`
+	Error      *struct {
+		Code    string `json:"code" example:"VALIDATION_ERROR"`
+		Message string `json:"message" example:"avatar must be a PNG, JPEG or GIF image"`
+	} `json:"error"`
+}
+
+type Failure429TooManyRequestsDoc struct {
+	failureDocBase
+	StatusCode int    `json:"status_code" example:"429"`
+	Message    string `json:"message" example:"too many failed login attempts"`
+	Error      *struct {
+		Code    string `json:"code" example:"TOO_MANY_REQUESTS"`
+		Message string `json:"message" example:"too many failed login attempts"`
+	} `json:"error"`
+}
+
 type Failure500InternalDoc struct {
 	failureDocBase
 	StatusCode int    `json:"status_code" example:"500"`
@@ -104,6 +144,55 @@ type AuthLoginSuccessDoc struct {
 	Data       AuthResponse `json:"data"`
 }
 
+type AuthRefreshSuccessDoc struct {
+	successDocBase
+	StatusCode int           `json:"status_code" example:"200"`
+	Message    string        `json:"message" example:"Token refreshed successfully"`
+	Data       TokenResponse `json:"data"`
+}
+
+type AuthLogoutSuccessDoc struct {
+	successDocBase
+	StatusCode int         `json:"status_code" example:"200"`
+	Message    string      `json:"message" example:"Successfully logged out"`
+	Data       interface{} `json:"data"`
+}
+
+type AuthLogoutAllSuccessDoc struct {
+	successDocBase
+	StatusCode int         `json:"status_code" example:"200"`
+	Message    string      `json:"message" example:"Successfully logged out from all sessions"`
+	Data       interface{} `json:"data"`
+}
+
+type AuthForgotPasswordSuccessDoc struct {
+	successDocBase
+	StatusCode int         `json:"status_code" example:"200"`
+	Message    string      `json:"message" example:"If the email is registered, a password reset link has been sent"`
+	Data       interface{} `json:"data"`
+}
+
+type AuthResetPasswordSuccessDoc struct {
+	successDocBase
+	StatusCode int         `json:"status_code" example:"200"`
+	Message    string      `json:"message" example:"Password has been reset successfully"`
+	Data       interface{} `json:"data"`
+}
+
+type AuthVerifyEmailSuccessDoc struct {
+	successDocBase
+	StatusCode int         `json:"status_code" example:"200"`
+	Message    string      `json:"message" example:"Email verified successfully"`
+	Data       interface{} `json:"data"`
+}
+
+type AuthResendVerificationSuccessDoc struct {
+	successDocBase
+	StatusCode int         `json:"status_code" example:"200"`
+	Message    string      `json:"message" example:"If the email is registered and unverified, a verification link has been sent"`
+	Data       interface{} `json:"data"`
+}
+
 // USER
 type UserProfileSuccessDoc struct {
 	successDocBase
@@ -112,6 +201,126 @@ type UserProfileSuccessDoc struct {
 	Data       UserResponse `json:"data"`
 }
 
+type UserUpdateProfileSuccessDoc struct {
+	successDocBase
+	StatusCode int          `json:"status_code" example:"200"`
+	Message    string       `json:"message" example:"Profile updated successfully"`
+	Data       UserResponse `json:"data"`
+}
+
+type UserChangePasswordSuccessDoc struct {
+	successDocBase
+	StatusCode int           `json:"status_code" example:"200"`
+	Message    string        `json:"message" example:"Password changed successfully"`
+	Data       TokenResponse `json:"data"`
+}
+
+type UserDeleteAccountSuccessDoc struct {
+	successDocBase
+	StatusCode int                     `json:"status_code" example:"200"`
+	Message    string                  `json:"message" example:"Account deleted successfully"`
+	Data       AccountDeletionResponse `json:"data"`
+}
+
+type AccessTokenCreateSuccessDoc struct {
+	successDocBase
+	StatusCode int                        `json:"status_code" example:"201"`
+	Message    string                     `json:"message" example:"Access token created successfully"`
+	Data       CreatedAccessTokenResponse `json:"data"`
+}
+
+type AccessTokenListSuccessDoc struct {
+	successDocBase
+	StatusCode int                   `json:"status_code" example:"200"`
+	Message    string                `json:"message" example:"Access tokens retrieved successfully"`
+	Data       []AccessTokenResponse `json:"data"`
+}
+
+type AccessTokenRevokeSuccessDoc struct {
+	successDocBase
+	StatusCode int         `json:"status_code" example:"200"`
+	Message    string      `json:"message" example:"Access token revoked successfully"`
+	Data       interface{} `json:"data"`
+}
+
+type SessionListSuccessDoc struct {
+	successDocBase
+	StatusCode int               `json:"status_code" example:"200"`
+	Message    string            `json:"message" example:"Sessions retrieved successfully"`
+	Data       []SessionResponse `json:"data"`
+}
+
+type SessionRevokeSuccessDoc struct {
+	successDocBase
+	StatusCode int         `json:"status_code" example:"200"`
+	Message    string      `json:"message" example:"Session revoked successfully"`
+	Data       interface{} `json:"data"`
+}
+
+type MFAStatusSuccessDoc struct {
+	successDocBase
+	StatusCode int               `json:"status_code" example:"200"`
+	Message    string            `json:"message" example:"Two-factor status retrieved successfully"`
+	Data       MFAStatusResponse `json:"data"`
+}
+
+type MFAChallengeSuccessDoc struct {
+	successDocBase
+	StatusCode int                  `json:"status_code" example:"200"`
+	Message    string               `json:"message" example:"Two-factor authentication required"`
+	Data       MFAChallengeResponse `json:"data"`
+}
+
+type MFAEnrollSuccessDoc struct {
+	successDocBase
+	StatusCode int                    `json:"status_code" example:"200"`
+	Message    string                 `json:"message" example:"TOTP enrolment started"`
+	Data       TOTPEnrollmentResponse `json:"data"`
+}
+
+type MFARecoveryCodesSuccessDoc struct {
+	successDocBase
+	StatusCode int                   `json:"status_code" example:"200"`
+	Message    string                `json:"message" example:"Two-factor authentication enabled"`
+	Data       RecoveryCodesResponse `json:"data"`
+}
+
+type MFADisableSuccessDoc struct {
+	successDocBase
+	StatusCode int         `json:"status_code" example:"200"`
+	Message    string      `json:"message" example:"Two-factor authentication disabled"`
+	Data       interface{} `json:"data"`
+}
+
+// ADMIN
+type AdminUserListSuccessDoc struct {
+	successDocBase
+	StatusCode int                   `json:"status_code" example:"200"`
+	Message    string                `json:"message" example:"Users retrieved successfully"`
+	Data       AdminUserListResponse `json:"data"`
+}
+
+type AdminUserSuspendSuccessDoc struct {
+	successDocBase
+	StatusCode int               `json:"status_code" example:"200"`
+	Message    string            `json:"message" example:"User suspended successfully"`
+	Data       AdminUserResponse `json:"data"`
+}
+
+type AdminForcePasswordResetSuccessDoc struct {
+	successDocBase
+	StatusCode int         `json:"status_code" example:"200"`
+	Message    string      `json:"message" example:"Password reset enforced successfully"`
+	Data       interface{} `json:"data"`
+}
+
+type AdminWorkspaceListSuccessDoc struct {
+	successDocBase
+	StatusCode int                        `json:"status_code" example:"200"`
+	Message    string                     `json:"message" example:"Workspaces retrieved successfully"`
+	Data       AdminWorkspaceListResponse `json:"data"`
+}
+
 // WORKSPACE
 type WorkspaceCreateSuccessDoc struct {
 	successDocBase
@@ -134,10 +343,171 @@ type WorkspaceDetailSuccessDoc struct {
 	Data       WorkspaceDetailResponse `json:"data"`
 }
 
+type WorkspaceMemberListSuccessDoc struct {
+	successDocBase
+	StatusCode int                         `json:"status_code" example:"200"`
+	Message    string                      `json:"message" example:"Workspace members retrieved successfully"`
+	Data       WorkspaceMemberPageResponse `json:"data"`
+}
+
+type WorkspaceUpdateSuccessDoc struct {
+	successDocBase
+	StatusCode int               `json:"status_code" example:"200"`
+	Message    string            `json:"message" example:"Workspace updated successfully"`
+	Data       WorkspaceResponse `json:"data"`
+}
+
+type WorkspaceDeleteSuccessDoc struct {
+	successDocBase
+	StatusCode int                       `json:"status_code" example:"200"`
+	Message    string                    `json:"message" example:"Workspace deleted successfully"`
+	Data       WorkspaceDeletionResponse `json:"data"`
+}
+
 type WorkspaceInviteSuccessDoc struct {
+	successDocBase
+	StatusCode int                     `json:"status_code" example:"200"`
+	Message    string                  `json:"message" example:"All invitations processed successfully"`
+	Data       WorkspaceInviteResponse `json:"data"`
+}
+
+type WorkspaceAcceptInvitationSuccessDoc struct {
+	successDocBase
+	StatusCode int                      `json:"status_code" example:"200"`
+	Message    string                   `json:"message" example:"Invitation accepted successfully"`
+	Data       AcceptInvitationResponse `json:"data"`
+}
+
+type WorkspaceUpdateMemberRoleSuccessDoc struct {
+	successDocBase
+	StatusCode int                     `json:"status_code" example:"200"`
+	Message    string                  `json:"message" example:"Member role updated successfully"`
+	Data       WorkspaceMemberResponse `json:"data"`
+}
+
+type WorkspaceTransferOwnershipSuccessDoc struct {
+	successDocBase
+	StatusCode int               `json:"status_code" example:"200"`
+	Message    string            `json:"message" example:"Workspace ownership transferred successfully"`
+	Data       WorkspaceResponse `json:"data"`
+}
+
+type WorkspaceLeaveSuccessDoc struct {
+	successDocBase
+	StatusCode int                    `json:"status_code" example:"200"`
+	Message    string                 `json:"message" example:"Left workspace successfully"`
+	Data       WorkspaceLeaveResponse `json:"data"`
+}
+
+type WorkspaceJoinLinkCreateSuccessDoc struct {
+	successDocBase
+	StatusCode int                              `json:"status_code" example:"201"`
+	Message    string                           `json:"message" example:"Join link created successfully"`
+	Data       CreatedWorkspaceJoinLinkResponse `json:"data"`
+}
+
+type WorkspaceJoinLinkListSuccessDoc struct {
+	successDocBase
+	StatusCode int                         `json:"status_code" example:"200"`
+	Message    string                      `json:"message" example:"Join links retrieved successfully"`
+	Data       []WorkspaceJoinLinkResponse `json:"data"`
+}
+
+type WorkspaceJoinLinkRevokeSuccessDoc struct {
 	successDocBase
 	StatusCode int         `json:"status_code" example:"200"`
-	Message    string      `json:"message" example:"Invitations sent"`
+	Message    string      `json:"message" example:"Join link revoked successfully"`
+	Data       interface{} `json:"data"`
+}
+
+type WorkspaceJoinSuccessDoc struct {
+	successDocBase
+	StatusCode int                      `json:"status_code" example:"200"`
+	Message    string                   `json:"message" example:"Joined workspace successfully"`
+	Data       AcceptInvitationResponse `json:"data"`
+}
+
+type WorkspaceEmailDomainCreateSuccessDoc struct {
+	successDocBase
+	StatusCode int                          `json:"status_code" example:"201"`
+	Message    string                       `json:"message" example:"Email domain added successfully"`
+	Data       WorkspaceEmailDomainResponse `json:"data"`
+}
+
+type WorkspaceEmailDomainListSuccessDoc struct {
+	successDocBase
+	StatusCode int                            `json:"status_code" example:"200"`
+	Message    string                         `json:"message" example:"Email domains retrieved successfully"`
+	Data       []WorkspaceEmailDomainResponse `json:"data"`
+}
+
+type WorkspaceEmailDomainVerifySuccessDoc struct {
+	successDocBase
+	StatusCode int                          `json:"status_code" example:"200"`
+	Message    string                       `json:"message" example:"Email domain verified successfully"`
+	Data       WorkspaceEmailDomainResponse `json:"data"`
+}
+
+type WorkspaceEmailDomainUpdateSuccessDoc struct {
+	successDocBase
+	StatusCode int                          `json:"status_code" example:"200"`
+	Message    string                       `json:"message" example:"Email domain updated successfully"`
+	Data       WorkspaceEmailDomainResponse `json:"data"`
+}
+
+type WorkspaceEmailDomainRemoveSuccessDoc struct {
+	successDocBase
+	StatusCode int         `json:"status_code" example:"200"`
+	Message    string      `json:"message" example:"Email domain removed successfully"`
+	Data       interface{} `json:"data"`
+}
+
+type WorkspaceGroupCreateSuccessDoc struct {
+	successDocBase
+	StatusCode int                          `json:"status_code" example:"201"`
+	Message    string                       `json:"message" example:"Group created successfully"`
+	Data       WorkspaceGroupDetailResponse `json:"data"`
+}
+
+type WorkspaceGroupListSuccessDoc struct {
+	successDocBase
+	StatusCode int                      `json:"status_code" example:"200"`
+	Message    string                   `json:"message" example:"Groups retrieved successfully"`
+	Data       []WorkspaceGroupResponse `json:"data"`
+}
+
+type WorkspaceGroupDetailSuccessDoc struct {
+	successDocBase
+	StatusCode int                          `json:"status_code" example:"200"`
+	Message    string                       `json:"message" example:"Group retrieved successfully"`
+	Data       WorkspaceGroupDetailResponse `json:"data"`
+}
+
+type WorkspaceGroupUpdateSuccessDoc struct {
+	successDocBase
+	StatusCode int                          `json:"status_code" example:"200"`
+	Message    string                       `json:"message" example:"Group updated successfully"`
+	Data       WorkspaceGroupDetailResponse `json:"data"`
+}
+
+type WorkspaceGroupDeleteSuccessDoc struct {
+	successDocBase
+	StatusCode int         `json:"status_code" example:"200"`
+	Message    string      `json:"message" example:"Group deleted successfully"`
+	Data       interface{} `json:"data"`
+}
+
+type WorkspaceGroupAddMembersSuccessDoc struct {
+	successDocBase
+	StatusCode int                          `json:"status_code" example:"200"`
+	Message    string                       `json:"message" example:"Group members added successfully"`
+	Data       WorkspaceGroupDetailResponse `json:"data"`
+}
+
+type WorkspaceGroupRemoveMemberSuccessDoc struct {
+	successDocBase
+	StatusCode int         `json:"status_code" example:"200"`
+	Message    string      `json:"message" example:"Group member removed successfully"`
 	Data       interface{} `json:"data"`
 }
 
@@ -198,6 +568,20 @@ type BoardInviteMembersSuccessDoc struct {
 	Data       interface{} `json:"data"`
 }
 
+type BoardTransferOwnershipSuccessDoc struct {
+	successDocBase
+	StatusCode int           `json:"status_code" example:"200"`
+	Message    string        `json:"message" example:"Board ownership transferred successfully"`
+	Data       BoardResponse `json:"data"`
+}
+
+type BoardUnlinkGroupSuccessDoc struct {
+	successDocBase
+	StatusCode int         `json:"status_code" example:"200"`
+	Message    string      `json:"message" example:"Group unlinked from the board"`
+	Data       interface{} `json:"data"`
+}
+
 type BoardRemoveMemberSuccessDoc struct {
 	successDocBase
 	StatusCode int         `json:"status_code" example:"200"`
@@ -0,0 +1,8 @@
+package response
+
+type AccountDeletionResponse struct {
+	TransferredWorkspaces int `json:"transferred_workspaces"`
+	DeletedWorkspaces     int `json:"deleted_workspaces"`
+	TransferredBoards     int `json:"transferred_boards"`
+	ReassignedCards       int `json:"reassigned_cards"`
+}
@@ -21,7 +21,11 @@ var tagToMessage = map[string]string{
 	"alpha":    "must contain only letters",
 	"alphanum": "must contain only letters and numbers",
 	"url":      "must be a valid URL",
+	"http_url": "must be an http or https URL",
 	"uuid":     "must be a valid UUID",
+
+	"required_without": "is required when %s is not set",
+	"excluded_with":    "must not be set together with %s",
 }
 
 func getValidationMessage(e validator.FieldError) string {
@@ -40,6 +40,26 @@ type WorkspaceDetailResponse struct {
 	Members  []WorkspaceMemberResponse `json:"members"`
 }
 
+type WorkspaceMemberPageResponse struct {
+	Members []WorkspaceMemberResponse `json:"members"`
+	// NextCursor is null on the last page.
+	NextCursor *string `json:"next_cursor"`
+}
+
+type WorkspaceInviteResultResponse struct {
+	Email  string `json:"email"`
+	Status string `json:"status"`
+}
+
+type WorkspaceInviteResponse struct {
+	Results []WorkspaceInviteResultResponse `json:"results"`
+}
+
+type AcceptInvitationResponse struct {
+	Workspace WorkspaceResponse    `json:"workspace"`
+	Role      entity.WorkspaceRole `json:"role"`
+}
+
 func WorkspaceDTOToResponse(d dto.WorkspaceDTO) WorkspaceResponse {
 	return WorkspaceResponse{
 		ID:          d.ID,
@@ -83,3 +103,126 @@ func WorkspaceDetailDTOToResponse(d dto.WorkspaceDetailDTO) WorkspaceDetailRespo
 		Members:           members,
 	}
 }
+
+type WorkspaceDeletionResponse struct {
+	DeletedBoards  int `json:"deleted_boards"`
+	DeletedColumns int `json:"deleted_columns"`
+	DeletedCards   int `json:"deleted_cards"`
+	RemovedMembers int `json:"removed_members"`
+}
+
+type WorkspaceLeaveResponse struct {
+	RemovedBoardMemberships int `json:"removed_board_memberships"`
+	TransferredBoards       int `json:"transferred_boards"`
+	UnassignedCards         int `json:"unassigned_cards"`
+}
+
+type WorkspaceJoinLinkResponse struct {
+	ID         uuid.UUID            `json:"id"`
+	CodePrefix string               `json:"code_prefix"`
+	Role       entity.WorkspaceRole `json:"role"`
+	MaxUses    *int                 `json:"max_uses"`
+	UseCount   int                  `json:"use_count"`
+	ExpiresAt  *time.Time           `json:"expires_at"`
+	CreatedBy  *uuid.UUID           `json:"created_by"`
+	CreatedAt  time.Time            `json:"created_at"`
+}
+
+type CreatedWorkspaceJoinLinkResponse struct {
+	WorkspaceJoinLinkResponse
+
+	// Code is only ever returned once, when the link is created.
+	Code string `json:"code"`
+}
+
+func WorkspaceJoinLinkDTOToResponse(d dto.WorkspaceJoinLinkDTO) WorkspaceJoinLinkResponse {
+	return WorkspaceJoinLinkResponse{
+		ID:         d.ID,
+		CodePrefix: d.CodePrefix,
+		Role:       d.Role,
+		MaxUses:    d.MaxUses,
+		UseCount:   d.UseCount,
+		ExpiresAt:  d.ExpiresAt,
+		CreatedBy:  d.CreatedBy,
+		CreatedAt:  d.CreatedAt,
+	}
+}
+
+type WorkspaceEmailDomainResponse struct {
+	ID         uuid.UUID  `json:"id"`
+	Domain     string     `json:"domain"`
+	Verified   bool       `json:"verified"`
+	VerifiedAt *time.Time `json:"verified_at"`
+	// RecordName and RecordValue describe the DNS TXT record that proves
+	// control of the domain.
+	RecordName      string      `json:"record_name"`
+	RecordValue     string      `json:"record_value"`
+	DefaultBoardIDs []uuid.UUID `json:"default_board_ids"`
+	CreatedAt       time.Time   `json:"created_at"`
+}
+
+func WorkspaceEmailDomainDTOToResponse(d dto.WorkspaceEmailDomainDTO) WorkspaceEmailDomainResponse {
+	return WorkspaceEmailDomainResponse{
+		ID:              d.ID,
+		Domain:          d.Domain,
+		Verified:        d.Verified,
+		VerifiedAt:      d.VerifiedAt,
+		RecordName:      d.RecordName,
+		RecordValue:     d.RecordValue,
+		DefaultBoardIDs: d.DefaultBoardIDs,
+		CreatedAt:       d.CreatedAt,
+	}
+}
+
+type WorkspaceGroupResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	Name        string     `json:"name"`
+	Description *string    `json:"description"`
+	MemberCount uint       `json:"member_count"`
+	CreatedBy   *uuid.UUID `json:"created_by"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+type WorkspaceGroupMemberResponse struct {
+	UserID    uuid.UUID `json:"id"`
+	Email     string    `json:"email"`
+	Name      string    `json:"name"`
+	AvatarURL *string   `json:"avatar_url"`
+	AddedAt   time.Time `json:"added_at"`
+}
+
+type WorkspaceGroupDetailResponse struct {
+	WorkspaceGroupResponse
+	Members []WorkspaceGroupMemberResponse `json:"members"`
+}
+
+func WorkspaceGroupDTOToResponse(d dto.WorkspaceGroupDTO) WorkspaceGroupResponse {
+	return WorkspaceGroupResponse{
+		ID:          d.ID,
+		Name:        d.Name,
+		Description: d.Description,
+		MemberCount: d.MemberCount,
+		CreatedBy:   d.CreatedBy,
+		CreatedAt:   d.CreatedAt,
+		UpdatedAt:   d.UpdatedAt,
+	}
+}
+
+func WorkspaceGroupDetailDTOToResponse(d dto.WorkspaceGroupDetailDTO) WorkspaceGroupDetailResponse {
+	members := make([]WorkspaceGroupMemberResponse, 0, len(d.Members))
+	for _, m := range d.Members {
+		members = append(members, WorkspaceGroupMemberResponse{
+			UserID:    m.UserID,
+			Email:     m.Email,
+			Name:      m.Name,
+			AvatarURL: m.AvatarURL,
+			AddedAt:   m.AddedAt,
+		})
+	}
+
+	return WorkspaceGroupDetailResponse{
+		WorkspaceGroupResponse: WorkspaceGroupDTOToResponse(d.WorkspaceGroupDTO),
+		Members:                members,
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"collabotask/internal/adapter/http/handler"
 	"collabotask/internal/adapter/http/middleware"
 	"collabotask/internal/config"
+	"collabotask/internal/domain/entity"
+	infraauth "collabotask/internal/infrastructure/auth"
+	"collabotask/internal/usecase/common"
 	"collabotask/pkg/logger"
 
 	"github.com/gin-gonic/gin"
@@ -12,49 +15,135 @@ import (
 )
 
 type Config struct {
-	Cfg              *config.Config
-	Log              *logger.Logger
-	AuthHandler      *handler.AuthHandler
-	UserHandler      *handler.UserHandler
-	WorkspaceHandler *handler.WorkspaceHandler
-	BoardHandler     *handler.BoardHandler
-	ColumnHandler    *handler.ColumnHandler
-	CardHandler      *handler.CardHandler
+	Cfg                *config.Config
+	Log                *logger.Logger
+	JWTKeys            *infraauth.JWTKeySet
+	RevocationStore    common.TokenRevocationStore
+	AccessTokenAuth    common.AccessTokenAuthenticator
+	AuthHandler        *handler.AuthHandler
+	UserHandler        *handler.UserHandler
+	WorkspaceHandler   *handler.WorkspaceHandler
+	BoardHandler       *handler.BoardHandler
+	ColumnHandler      *handler.ColumnHandler
+	CardHandler        *handler.CardHandler
+	AdminHandler       *handler.AdminHandler
+	AccessTokenHandler *handler.AccessTokenHandler
+	MFAHandler         *handler.MFAHandler
+	JWKSHandler        *handler.JWKSHandler
+	SessionHandler     *handler.SessionHandler
+	AvatarHandler      *handler.AvatarHandler
 }
 
 func New(cfg Config) *gin.Engine {
-	routes := gin.New()
+	routes, err := newEngine(cfg.Cfg.Server.TrustedProxies)
+	if err != nil {
+		cfg.Log.WithError(err).Fatal("failed to set trusted proxies")
+	}
 
 	routes.Use(middleware.Recover(cfg.Log))
 	routes.Use(middleware.Logger(cfg.Log))
 	routes.Use(middleware.CORS(&cfg.Cfg.CORS))
 
 	routes.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+	routes.GET("/.well-known/jwks.json", cfg.JWKSHandler.GetJWKS)
 
 	v1Routes := routes.Group("/api/v1")
+	authMiddleware := middleware.Auth(cfg.JWTKeys, cfg.RevocationStore, cfg.AccessTokenAuth)
+	sessionOnly := middleware.RequireSession()
 
 	// Public routes
 	auth := v1Routes.Group("/auth")
 	{
 		auth.POST("/register", cfg.AuthHandler.Register)
 		auth.POST("/login", cfg.AuthHandler.Login)
+		auth.POST("/mfa/verify", cfg.AuthHandler.VerifyMFA)
+		auth.GET("/oidc/login", cfg.AuthHandler.StartOIDCLogin)
+		auth.GET("/oidc/callback", cfg.AuthHandler.CompleteOIDCLogin)
+		auth.POST("/refresh", cfg.AuthHandler.RefreshToken)
+		auth.POST("/logout", authMiddleware, sessionOnly, cfg.AuthHandler.Logout)
+		auth.POST("/logout-all", authMiddleware, sessionOnly, cfg.AuthHandler.LogoutAll)
+		auth.POST("/password/forgot", cfg.AuthHandler.ForgotPassword)
+		auth.POST("/password/reset", cfg.AuthHandler.ResetPassword)
+		auth.GET("/verify", cfg.AuthHandler.VerifyEmail)
+		auth.POST("/verify/resend", cfg.AuthHandler.ResendVerificationEmail)
 	}
 
+	// Avatars are public so they work in plain img tags.
+	v1Routes.GET("/users/:user_id/avatar", cfg.AvatarHandler.GetAvatar)
+
 	// Protected routes
 	user := v1Routes.Group("/user")
-	user.Use(middleware.Auth(&cfg.Cfg.Auth))
+	user.Use(authMiddleware)
 	{
 		user.GET("/profile", cfg.UserHandler.GetProfile)
+		user.PATCH("/profile", cfg.UserHandler.UpdateProfile)
+		user.POST("/avatar", cfg.AvatarHandler.UploadAvatar)
+		user.DELETE("/avatar", cfg.AvatarHandler.DeleteAvatar)
+		user.POST("/password", sessionOnly, cfg.UserHandler.ChangePassword)
+		user.DELETE("", sessionOnly, cfg.UserHandler.DeleteAccount)
+		user.POST("/tokens", sessionOnly, cfg.AccessTokenHandler.CreateAccessToken)
+		user.GET("/tokens", cfg.AccessTokenHandler.ListAccessTokens)
+		user.DELETE("/tokens/:token_id", cfg.AccessTokenHandler.RevokeAccessToken)
+		user.GET("/sessions", cfg.SessionHandler.ListSessions)
+		user.DELETE("/sessions/:session_id", sessionOnly, cfg.SessionHandler.RevokeSession)
+		user.GET("/mfa", cfg.MFAHandler.GetMFAStatus)
+		user.POST("/mfa/totp", sessionOnly, cfg.MFAHandler.EnrollTOTP)
+		user.POST("/mfa/totp/confirm", sessionOnly, cfg.MFAHandler.ConfirmTOTP)
+		user.DELETE("/mfa/totp", sessionOnly, cfg.MFAHandler.DisableTOTP)
+		user.POST("/mfa/recovery-codes", sessionOnly, cfg.MFAHandler.RegenerateRecoveryCodes)
+	}
+
+	admin := v1Routes.Group("/admin")
+	admin.Use(authMiddleware, sessionOnly, middleware.RequireSystemRole(entity.SystemRoleSuperAdmin))
+	{
+		admin.GET("/users", cfg.AdminHandler.ListUsers)
+		admin.POST("/users/:user_id/suspend", cfg.AdminHandler.SuspendUser)
+		admin.POST("/users/:user_id/unsuspend", cfg.AdminHandler.UnsuspendUser)
+		admin.POST("/users/:user_id/force-password-reset", cfg.AdminHandler.ForcePasswordReset)
+		admin.GET("/workspaces", cfg.AdminHandler.ListWorkspaces)
+	}
+
+	invitations := v1Routes.Group("/invitations")
+	invitations.Use(authMiddleware)
+	{
+		invitations.POST("/:token/accept", cfg.WorkspaceHandler.AcceptInvitation)
+	}
+
+	join := v1Routes.Group("/join")
+	join.Use(authMiddleware)
+	{
+		join.POST("/:code", cfg.WorkspaceHandler.JoinByLink)
 	}
 
 	workspaces := v1Routes.Group("/workspace")
-	workspaces.Use(middleware.Auth(&cfg.Cfg.Auth))
+	workspaces.Use(authMiddleware)
 	{
 		workspaces.POST("", cfg.WorkspaceHandler.CreateWorkspace)
 		workspaces.GET("", cfg.WorkspaceHandler.GetWorkspaces)
 		workspaces.GET("/:workspace_id", cfg.WorkspaceHandler.GetWorkspaceDetail)
+		workspaces.PATCH("/:workspace_id", cfg.WorkspaceHandler.UpdateWorkspace)
+		workspaces.DELETE("/:workspace_id", cfg.WorkspaceHandler.DeleteWorkspace)
+		workspaces.GET("/:workspace_id/members", cfg.WorkspaceHandler.ListMembers)
 		workspaces.POST("/:workspace_id/member/invite", cfg.WorkspaceHandler.InviteMember)
 		workspaces.DELETE("/:workspace_id/member/remove/:user_id", cfg.WorkspaceHandler.RemoveMember)
+		workspaces.PATCH("/:workspace_id/member/:user_id", cfg.WorkspaceHandler.UpdateMemberRole)
+		workspaces.POST("/:workspace_id/transfer-ownership", cfg.WorkspaceHandler.TransferOwnership)
+		workspaces.POST("/:workspace_id/leave", cfg.WorkspaceHandler.LeaveWorkspace)
+		workspaces.POST("/:workspace_id/join-links", cfg.WorkspaceHandler.CreateJoinLink)
+		workspaces.GET("/:workspace_id/join-links", cfg.WorkspaceHandler.ListJoinLinks)
+		workspaces.DELETE("/:workspace_id/join-links/:link_id", cfg.WorkspaceHandler.RevokeJoinLink)
+		workspaces.POST("/:workspace_id/email-domains", cfg.WorkspaceHandler.AddEmailDomain)
+		workspaces.GET("/:workspace_id/email-domains", cfg.WorkspaceHandler.ListEmailDomains)
+		workspaces.POST("/:workspace_id/email-domains/:domain_id/verify", cfg.WorkspaceHandler.VerifyEmailDomain)
+		workspaces.PATCH("/:workspace_id/email-domains/:domain_id", cfg.WorkspaceHandler.SetEmailDomainBoards)
+		workspaces.DELETE("/:workspace_id/email-domains/:domain_id", cfg.WorkspaceHandler.RemoveEmailDomain)
+		workspaces.POST("/:workspace_id/groups", cfg.WorkspaceHandler.CreateGroup)
+		workspaces.GET("/:workspace_id/groups", cfg.WorkspaceHandler.ListGroups)
+		workspaces.GET("/:workspace_id/groups/:group_id", cfg.WorkspaceHandler.GetGroup)
+		workspaces.PATCH("/:workspace_id/groups/:group_id", cfg.WorkspaceHandler.UpdateGroup)
+		workspaces.DELETE("/:workspace_id/groups/:group_id", cfg.WorkspaceHandler.DeleteGroup)
+		workspaces.POST("/:workspace_id/groups/:group_id/members", cfg.WorkspaceHandler.AddGroupMembers)
+		workspaces.DELETE("/:workspace_id/groups/:group_id/members/:user_id", cfg.WorkspaceHandler.RemoveGroupMember)
 
 		boards := workspaces.Group("/:workspace_id/board")
 		{
@@ -65,10 +154,12 @@ func New(cfg Config) *gin.Engine {
 			boards.PATCH("/:board_id", cfg.BoardHandler.UpdateBoard)
 			boards.POST("/:board_id/archive", cfg.BoardHandler.SetBoardArchivedStatus)
 			boards.POST("/:board_id/invite", cfg.BoardHandler.InviteMembersToBoard)
+			boards.DELETE("/:board_id/groups/:group_id", cfg.BoardHandler.UnlinkGroup)
 			boards.DELETE("/:board_id/member", cfg.BoardHandler.RemoveMemberFromBoard)
 			boards.GET("/:board_id/invitees", cfg.BoardHandler.GetWorkspaceInviteesForBoard)
 			boards.POST("/:board_id/join", cfg.BoardHandler.SelfJoinToBoard)
 			boards.POST("/:board_id/leave", cfg.BoardHandler.LeaveBoard)
+			boards.POST("/:board_id/transfer-ownership", cfg.BoardHandler.TransferOwnership)
 		}
 
 		columns := boards.Group("/:board_id/columns")
@@ -90,3 +181,15 @@ func New(cfg Config) *gin.Engine {
 
 	return routes
 }
+
+// newEngine only honours X-Forwarded-For from the given proxies. Gin trusts
+// every proxy by default, which would let clients pick their own IP and
+// dodge the per-IP login throttle.
+func newEngine(trustedProxies []string) (*gin.Engine, error) {
+	routes := gin.New()
+	if err := routes.SetTrustedProxies(trustedProxies); err != nil {
+		return nil, err
+	}
+
+	return routes, nil
+}
@@ -0,0 +1,87 @@
+package router
+
+import (
+	"collabotask/internal/config"
+	"collabotask/internal/usecase/common"
+	"collabotask/pkg/logger"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// newThrottledEngine mimics the login handler: every request is a failed
+// attempt for a fresh account, so only the per-IP counter can block it.
+func newThrottledEngine(t *testing.T, trustedProxies []string) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	routes, err := newEngine(trustedProxies)
+	if err != nil {
+		t.Fatalf("Failed to create engine: %v", err)
+	}
+
+	throttler := common.NewLoginThrottler(&config.AuthConfig{
+		LoginMaxAccountFailures: 5,
+		LoginMaxIPFailures:      3,
+		LoginBackoffBase:        time.Minute,
+		LoginLockoutDuration:    time.Hour,
+		LoginFailureWindow:      time.Hour,
+	}, logger.New(logger.Config{Level: "error"}))
+
+	attempt := 0
+	routes.POST("/login", func(ctx *gin.Context) {
+		attempt++
+		email := fmt.Sprintf("user%d@example.com", attempt)
+		if throttler.RetryAfter(email, ctx.ClientIP()) > 0 {
+			ctx.Status(http.StatusTooManyRequests)
+			return
+		}
+		throttler.RecordFailure(email, ctx.ClientIP())
+		ctx.Status(http.StatusUnauthorized)
+	})
+
+	return routes
+}
+
+func login(routes *gin.Engine, remoteAddr, forwardedFor string) int {
+	req := httptest.NewRequest(http.MethodPost, "/login", nil)
+	req.RemoteAddr = remoteAddr
+	if forwardedFor != "" {
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+	}
+
+	rec := httptest.NewRecorder()
+	routes.ServeHTTP(rec, req)
+	return rec.Code
+}
+
+func TestSpoofedForwardedForDoesNotResetIPThrottle(t *testing.T) {
+	routes := newThrottledEngine(t, nil)
+
+	for i := 0; i < 4; i++ {
+		login(routes, "203.0.113.7:40000", fmt.Sprintf("198.51.100.%d", i))
+	}
+
+	if code := login(routes, "203.0.113.7:40000", "198.51.100.200"); code != http.StatusTooManyRequests {
+		t.Errorf("Expected status %d with a spoofed X-Forwarded-For, got %d", http.StatusTooManyRequests, code)
+	}
+}
+
+func TestTrustedProxyForwardedForIsHonoured(t *testing.T) {
+	routes := newThrottledEngine(t, []string{"10.0.0.1"})
+
+	for i := 0; i < 4; i++ {
+		login(routes, "10.0.0.1:40000", "203.0.113.7")
+	}
+
+	if code := login(routes, "10.0.0.1:40000", "198.51.100.9"); code != http.StatusUnauthorized {
+		t.Errorf("Expected status %d for another client behind the proxy, got %d", http.StatusUnauthorized, code)
+	}
+	if code := login(routes, "10.0.0.1:40000", "203.0.113.7"); code != http.StatusTooManyRequests {
+		t.Errorf("Expected status %d for the throttled client, got %d", http.StatusTooManyRequests, code)
+	}
+}
@@ -34,7 +34,7 @@ const (
 				ELSE NULL
 			END AS user_role,
 			CASE
-				WHEN bm.user_id IS NOT NULL OR b.created_by = $2 THEN 'JOINED'
+				WHEN bm.user_id IS NOT NULL OR (b.created_by = $2 AND wm.role <> 'GUEST') THEN 'JOINED'
 				WHEN wm.role = 'ADMIN' THEN 'CAN_JOIN'
 				ELSE NULL
 			END AS access_status,
@@ -47,12 +47,30 @@ const (
 			AND b.is_archived = FALSE
 			AND (
 				wm.role = 'ADMIN'
-				OR b.created_by = $2
+				OR (b.created_by = $2 AND wm.role <> 'GUEST')
 				OR bm.user_id IS NOT NULL
 			)
 		GROUP BY b.id, b.workspace_id, b.title, b.description, b.created_by, b.is_archived, b.background_color, b.created_at, b.updated_at, wm.role, bm.role, bm.user_id
 		ORDER BY b.created_at DESC
 	`
+	transferBoardOwnershipQuery = `
+		UPDATE boards
+		SET created_by = $3, updated_at = $4
+		WHERE id = $1 AND created_by = $2
+		RETURNING id, workspace_id, title, description, created_by, is_archived, background_color, created_at, updated_at
+	`
+	// A synced group no longer grants the membership once it carries
+	// ownership, so leaving the group does not remove the owner.
+	promoteBoardOwnerQuery = `
+		UPDATE board_members
+		SET role = 'BOARD_OWNER', added_via_group = FALSE
+		WHERE board_id = $1 AND user_id = $2
+	`
+	demoteOtherBoardOwnersQuery = `
+		UPDATE board_members
+		SET role = 'BOARD_MEMBER'
+		WHERE board_id = $1 AND user_id <> $2 AND role = 'BOARD_OWNER'
+	`
 	setBoardArchivedQuery = `
 		UPDATE boards SET is_archived = $2, updated_at = CURRENT_TIMESTAMP
 		WHERE id = $1
@@ -308,3 +308,48 @@ func (br *BoardRepositoryImpl) SetArchived(ctx context.Context, boardID uuid.UUI
 
 	return nil
 }
+
+func (br *BoardRepositoryImpl) TransferOwnership(ctx context.Context, boardID, fromUserID, toUserID uuid.UUID) (*entity.Board, error) {
+	tx, err := br.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transfer board ownership transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	board := &entity.Board{}
+	err = tx.QueryRow(ctx, transferBoardOwnershipQuery, boardID, fromUserID, toUserID, time.Now().UTC()).Scan(
+		&board.ID,
+		&board.WorkspaceID,
+		&board.Title,
+		&board.Description,
+		&board.CreatedBy,
+		&board.IsArchived,
+		&board.BackgroundColor,
+		&board.CreatedAt,
+		&board.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrBoardOwnerChanged
+		}
+		return nil, fmt.Errorf("failed to transfer board ownership: %w", err)
+	}
+
+	result, err := tx.Exec(ctx, promoteBoardOwnerQuery, boardID, toUserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to promote new board owner: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return nil, domain.ErrBoardMemberNotFound
+	}
+
+	if _, err := tx.Exec(ctx, demoteOtherBoardOwnersQuery, boardID, toUserID); err != nil {
+		return nil, fmt.Errorf("failed to demote previous board owner: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return board, nil
+}
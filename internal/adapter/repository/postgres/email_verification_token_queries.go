@@ -0,0 +1,24 @@
+package postgres
+
+const (
+	createEmailVerificationTokenQuery = `
+		INSERT INTO email_verification_tokens (user_id, token_hash, expires_at, created_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, user_id, token_hash, expires_at, used_at, created_at
+	`
+	getEmailVerificationTokenByHashQuery = `
+		SELECT id, user_id, token_hash, expires_at, used_at, created_at
+		FROM email_verification_tokens
+		WHERE token_hash = $1
+	`
+	markEmailVerificationTokenUsedQuery = `
+		UPDATE email_verification_tokens
+		SET used_at = $2
+		WHERE id = $1 AND used_at IS NULL
+	`
+	invalidateEmailVerificationTokensByUserQuery = `
+		UPDATE email_verification_tokens
+		SET used_at = $2
+		WHERE user_id = $1 AND used_at IS NULL
+	`
+)
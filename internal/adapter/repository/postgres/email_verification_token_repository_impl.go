@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/domain/entity"
+	"collabotask/internal/domain/repository"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type EmailVerificationTokenRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewEmailVerificationTokenRepository(db *pgxpool.Pool) repository.EmailVerificationTokenRepository {
+	return &EmailVerificationTokenRepositoryImpl{db: db}
+}
+
+func (er *EmailVerificationTokenRepositoryImpl) Create(ctx context.Context, token *entity.EmailVerificationToken) error {
+	err := er.db.QueryRow(
+		ctx,
+		createEmailVerificationTokenQuery,
+		token.UserID,
+		token.TokenHash,
+		token.ExpiresAt,
+		time.Now().UTC(),
+	).Scan(
+		&token.ID,
+		&token.UserID,
+		&token.TokenHash,
+		&token.ExpiresAt,
+		&token.UsedAt,
+		&token.CreatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return domain.ErrConstraintViolation
+		}
+		return fmt.Errorf("failed to create email verification token: %w", err)
+	}
+
+	return nil
+}
+
+func (er *EmailVerificationTokenRepositoryImpl) GetByTokenHash(ctx context.Context, tokenHash string) (*entity.EmailVerificationToken, error) {
+	token := &entity.EmailVerificationToken{}
+	err := er.db.QueryRow(ctx, getEmailVerificationTokenByHashQuery, tokenHash).Scan(
+		&token.ID,
+		&token.UserID,
+		&token.TokenHash,
+		&token.ExpiresAt,
+		&token.UsedAt,
+		&token.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrInvalidEmailVerificationToken
+		}
+		return nil, fmt.Errorf("failed to get email verification token: %w", err)
+	}
+
+	return token, nil
+}
+
+func (er *EmailVerificationTokenRepositoryImpl) MarkUsed(ctx context.Context, tokenID uuid.UUID) error {
+	result, err := er.db.Exec(ctx, markEmailVerificationTokenUsedQuery, tokenID, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to mark email verification token as used: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrInvalidEmailVerificationToken
+	}
+
+	return nil
+}
+
+func (er *EmailVerificationTokenRepositoryImpl) InvalidateByUser(ctx context.Context, userID uuid.UUID) error {
+	_, err := er.db.Exec(ctx, invalidateEmailVerificationTokensByUserQuery, userID, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to invalidate email verification tokens: %w", err)
+	}
+
+	return nil
+}
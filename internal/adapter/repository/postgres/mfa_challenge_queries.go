@@ -0,0 +1,24 @@
+package postgres
+
+const (
+	createMFAChallengeQuery = `
+		INSERT INTO mfa_challenges (user_id, token_hash, expires_at, created_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, user_id, token_hash, expires_at, attempts, used_at, created_at
+	`
+	getMFAChallengeByHashQuery = `
+		SELECT id, user_id, token_hash, expires_at, attempts, used_at, created_at
+		FROM mfa_challenges
+		WHERE token_hash = $1
+	`
+	registerMFAChallengeFailureQuery = `
+		UPDATE mfa_challenges
+		SET attempts = attempts + 1
+		WHERE id = $1
+	`
+	markMFAChallengeUsedQuery = `
+		UPDATE mfa_challenges
+		SET used_at = $2
+		WHERE id = $1 AND used_at IS NULL
+	`
+)
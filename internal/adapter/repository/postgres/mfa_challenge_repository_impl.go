@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/domain/entity"
+	"collabotask/internal/domain/repository"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type MFAChallengeRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewMFAChallengeRepository(db *pgxpool.Pool) repository.MFAChallengeRepository {
+	return &MFAChallengeRepositoryImpl{db: db}
+}
+
+func (cr *MFAChallengeRepositoryImpl) Create(ctx context.Context, challenge *entity.MFAChallenge) error {
+	err := cr.db.QueryRow(
+		ctx,
+		createMFAChallengeQuery,
+		challenge.UserID,
+		challenge.TokenHash,
+		challenge.ExpiresAt,
+		time.Now().UTC(),
+	).Scan(
+		&challenge.ID,
+		&challenge.UserID,
+		&challenge.TokenHash,
+		&challenge.ExpiresAt,
+		&challenge.Attempts,
+		&challenge.UsedAt,
+		&challenge.CreatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return domain.ErrConstraintViolation
+		}
+		return fmt.Errorf("failed to create mfa challenge: %w", err)
+	}
+
+	return nil
+}
+
+func (cr *MFAChallengeRepositoryImpl) GetByTokenHash(ctx context.Context, tokenHash string) (*entity.MFAChallenge, error) {
+	challenge := &entity.MFAChallenge{}
+	err := cr.db.QueryRow(ctx, getMFAChallengeByHashQuery, tokenHash).Scan(
+		&challenge.ID,
+		&challenge.UserID,
+		&challenge.TokenHash,
+		&challenge.ExpiresAt,
+		&challenge.Attempts,
+		&challenge.UsedAt,
+		&challenge.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrInvalidMFAChallenge
+		}
+		return nil, fmt.Errorf("failed to get mfa challenge: %w", err)
+	}
+
+	return challenge, nil
+}
+
+func (cr *MFAChallengeRepositoryImpl) RegisterFailedAttempt(ctx context.Context, challengeID uuid.UUID) error {
+	if _, err := cr.db.Exec(ctx, registerMFAChallengeFailureQuery, challengeID); err != nil {
+		return fmt.Errorf("failed to record mfa attempt: %w", err)
+	}
+
+	return nil
+}
+
+func (cr *MFAChallengeRepositoryImpl) MarkUsed(ctx context.Context, challengeID uuid.UUID) error {
+	result, err := cr.db.Exec(ctx, markMFAChallengeUsedQuery, challengeID, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to mark mfa challenge as used: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrInvalidMFAChallenge
+	}
+
+	return nil
+}
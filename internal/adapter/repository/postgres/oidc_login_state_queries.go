@@ -0,0 +1,18 @@
+package postgres
+
+const (
+	createOIDCLoginStateQuery = `
+		INSERT INTO oidc_login_states (state_hash, nonce, code_verifier, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, state_hash, nonce, code_verifier, expires_at, created_at
+	`
+	consumeOIDCLoginStateQuery = `
+		DELETE FROM oidc_login_states
+		WHERE state_hash = $1
+		RETURNING id, state_hash, nonce, code_verifier, expires_at, created_at
+	`
+	deleteExpiredOIDCLoginStatesQuery = `
+		DELETE FROM oidc_login_states
+		WHERE expires_at <= $1
+	`
+)
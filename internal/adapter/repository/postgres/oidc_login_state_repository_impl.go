@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/domain/entity"
+	"collabotask/internal/domain/repository"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type OIDCLoginStateRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewOIDCLoginStateRepository(db *pgxpool.Pool) repository.OIDCLoginStateRepository {
+	return &OIDCLoginStateRepositoryImpl{db: db}
+}
+
+func (sr *OIDCLoginStateRepositoryImpl) Create(ctx context.Context, state *entity.OIDCLoginState) error {
+	now := time.Now().UTC()
+
+	// Abandoned logins never reach the callback, so they are swept here.
+	if _, err := sr.db.Exec(ctx, deleteExpiredOIDCLoginStatesQuery, now); err != nil {
+		return fmt.Errorf("failed to purge expired oidc states: %w", err)
+	}
+
+	err := sr.db.QueryRow(
+		ctx,
+		createOIDCLoginStateQuery,
+		state.StateHash,
+		state.Nonce,
+		state.CodeVerifier,
+		state.ExpiresAt,
+		now,
+	).Scan(
+		&state.ID,
+		&state.StateHash,
+		&state.Nonce,
+		&state.CodeVerifier,
+		&state.ExpiresAt,
+		&state.CreatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return domain.ErrConstraintViolation
+		}
+		return fmt.Errorf("failed to create oidc state: %w", err)
+	}
+
+	return nil
+}
+
+func (sr *OIDCLoginStateRepositoryImpl) Consume(ctx context.Context, stateHash string) (*entity.OIDCLoginState, error) {
+	state := &entity.OIDCLoginState{}
+	err := sr.db.QueryRow(ctx, consumeOIDCLoginStateQuery, stateHash).Scan(
+		&state.ID,
+		&state.StateHash,
+		&state.Nonce,
+		&state.CodeVerifier,
+		&state.ExpiresAt,
+		&state.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrInvalidOIDCState
+		}
+		return nil, fmt.Errorf("failed to consume oidc state: %w", err)
+	}
+
+	return state, nil
+}
@@ -0,0 +1,24 @@
+package postgres
+
+const (
+	createPasswordResetTokenQuery = `
+		INSERT INTO password_reset_tokens (user_id, token_hash, expires_at, created_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, user_id, token_hash, expires_at, used_at, created_at
+	`
+	getPasswordResetTokenByHashQuery = `
+		SELECT id, user_id, token_hash, expires_at, used_at, created_at
+		FROM password_reset_tokens
+		WHERE token_hash = $1
+	`
+	markPasswordResetTokenUsedQuery = `
+		UPDATE password_reset_tokens
+		SET used_at = $2
+		WHERE id = $1 AND used_at IS NULL
+	`
+	invalidatePasswordResetTokensByUserQuery = `
+		UPDATE password_reset_tokens
+		SET used_at = $2
+		WHERE user_id = $1 AND used_at IS NULL
+	`
+)
@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/domain/entity"
+	"collabotask/internal/domain/repository"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PasswordResetTokenRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewPasswordResetTokenRepository(db *pgxpool.Pool) repository.PasswordResetTokenRepository {
+	return &PasswordResetTokenRepositoryImpl{db: db}
+}
+
+func (pr *PasswordResetTokenRepositoryImpl) Create(ctx context.Context, token *entity.PasswordResetToken) error {
+	err := pr.db.QueryRow(
+		ctx,
+		createPasswordResetTokenQuery,
+		token.UserID,
+		token.TokenHash,
+		token.ExpiresAt,
+		time.Now().UTC(),
+	).Scan(
+		&token.ID,
+		&token.UserID,
+		&token.TokenHash,
+		&token.ExpiresAt,
+		&token.UsedAt,
+		&token.CreatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return domain.ErrConstraintViolation
+		}
+		return fmt.Errorf("failed to create password reset token: %w", err)
+	}
+
+	return nil
+}
+
+func (pr *PasswordResetTokenRepositoryImpl) GetByTokenHash(ctx context.Context, tokenHash string) (*entity.PasswordResetToken, error) {
+	token := &entity.PasswordResetToken{}
+	err := pr.db.QueryRow(ctx, getPasswordResetTokenByHashQuery, tokenHash).Scan(
+		&token.ID,
+		&token.UserID,
+		&token.TokenHash,
+		&token.ExpiresAt,
+		&token.UsedAt,
+		&token.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrInvalidPasswordResetToken
+		}
+		return nil, fmt.Errorf("failed to get password reset token: %w", err)
+	}
+
+	return token, nil
+}
+
+func (pr *PasswordResetTokenRepositoryImpl) MarkUsed(ctx context.Context, tokenID uuid.UUID) error {
+	result, err := pr.db.Exec(ctx, markPasswordResetTokenUsedQuery, tokenID, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to mark password reset token as used: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrInvalidPasswordResetToken
+	}
+
+	return nil
+}
+
+func (pr *PasswordResetTokenRepositoryImpl) InvalidateByUser(ctx context.Context, userID uuid.UUID) error {
+	_, err := pr.db.Exec(ctx, invalidatePasswordResetTokensByUserQuery, userID, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to invalidate password reset tokens: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package postgres
+
+const (
+	createPersonalAccessTokenQuery = `
+		INSERT INTO personal_access_tokens (user_id, name, token_prefix, token_hash, scope, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at
+	`
+	getPersonalAccessTokenByHashQuery = `
+		SELECT id, user_id, name, token_prefix, token_hash, scope, expires_at, last_used_at, revoked_at, created_at
+		FROM personal_access_tokens
+		WHERE token_hash = $1
+	`
+	listPersonalAccessTokensByUserQuery = `
+		SELECT id, user_id, name, token_prefix, token_hash, scope, expires_at, last_used_at, revoked_at, created_at
+		FROM personal_access_tokens
+		WHERE user_id = $1 AND revoked_at IS NULL
+		ORDER BY created_at DESC
+	`
+	revokePersonalAccessTokenQuery = `
+		UPDATE personal_access_tokens
+		SET revoked_at = $3
+		WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL
+	`
+	revokeAllPersonalAccessTokensByUserQuery = `
+		UPDATE personal_access_tokens
+		SET revoked_at = $2
+		WHERE user_id = $1 AND revoked_at IS NULL
+	`
+	touchPersonalAccessTokenQuery = `
+		UPDATE personal_access_tokens
+		SET last_used_at = $2
+		WHERE id = $1
+	`
+)
@@ -0,0 +1,133 @@
+package postgres
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/domain/entity"
+	"collabotask/internal/domain/repository"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PersonalAccessTokenRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewPersonalAccessTokenRepository(db *pgxpool.Pool) repository.PersonalAccessTokenRepository {
+	return &PersonalAccessTokenRepositoryImpl{db: db}
+}
+
+func (pr *PersonalAccessTokenRepositoryImpl) Create(ctx context.Context, token *entity.PersonalAccessToken) error {
+	var expiresAt *time.Time
+	if token.ExpiresAt != nil {
+		at := token.ExpiresAt.UTC()
+		expiresAt = &at
+	}
+
+	err := pr.db.QueryRow(
+		ctx,
+		createPersonalAccessTokenQuery,
+		token.UserID,
+		token.Name,
+		token.TokenPrefix,
+		token.TokenHash,
+		token.Scope,
+		expiresAt,
+		time.Now().UTC(),
+	).Scan(&token.ID, &token.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create personal access token: %w", err)
+	}
+
+	return nil
+}
+
+func (pr *PersonalAccessTokenRepositoryImpl) GetByTokenHash(ctx context.Context, tokenHash string) (*entity.PersonalAccessToken, error) {
+	token, err := scanPersonalAccessToken(pr.db.QueryRow(ctx, getPersonalAccessTokenByHashQuery, tokenHash))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrInvalidAccessToken
+		}
+		return nil, fmt.Errorf("failed to get personal access token: %w", err)
+	}
+
+	return token, nil
+}
+
+func (pr *PersonalAccessTokenRepositoryImpl) ListByUser(ctx context.Context, userID uuid.UUID) ([]*entity.PersonalAccessToken, error) {
+	rows, err := pr.db.Query(ctx, listPersonalAccessTokensByUserQuery, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list personal access tokens: %w", err)
+	}
+	defer rows.Close()
+
+	tokens := []*entity.PersonalAccessToken{}
+	for rows.Next() {
+		token, err := scanPersonalAccessToken(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan personal access token: %w", err)
+		}
+		tokens = append(tokens, token)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating personal access tokens: %w", err)
+	}
+
+	return tokens, nil
+}
+
+func (pr *PersonalAccessTokenRepositoryImpl) Revoke(ctx context.Context, id, userID uuid.UUID) error {
+	result, err := pr.db.Exec(ctx, revokePersonalAccessTokenQuery, id, userID, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to revoke personal access token: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrAccessTokenNotFound
+	}
+
+	return nil
+}
+
+func (pr *PersonalAccessTokenRepositoryImpl) RevokeAllByUser(ctx context.Context, userID uuid.UUID) error {
+	if _, err := pr.db.Exec(ctx, revokeAllPersonalAccessTokensByUserQuery, userID, time.Now().UTC()); err != nil {
+		return fmt.Errorf("failed to revoke personal access tokens: %w", err)
+	}
+
+	return nil
+}
+
+func (pr *PersonalAccessTokenRepositoryImpl) TouchLastUsed(ctx context.Context, id uuid.UUID, usedAt time.Time) error {
+	if _, err := pr.db.Exec(ctx, touchPersonalAccessTokenQuery, id, usedAt.UTC()); err != nil {
+		return fmt.Errorf("failed to update personal access token usage: %w", err)
+	}
+
+	return nil
+}
+
+func scanPersonalAccessToken(row pgx.Row) (*entity.PersonalAccessToken, error) {
+	token := &entity.PersonalAccessToken{}
+	err := row.Scan(
+		&token.ID,
+		&token.UserID,
+		&token.Name,
+		&token.TokenPrefix,
+		&token.TokenHash,
+		&token.Scope,
+		&token.ExpiresAt,
+		&token.LastUsedAt,
+		&token.RevokedAt,
+		&token.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
@@ -0,0 +1,29 @@
+package postgres
+
+const (
+	createRefreshTokenQuery = `
+		INSERT INTO refresh_tokens (user_id, family_id, token_hash, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, user_id, family_id, token_hash, expires_at, used_at, revoked_at, created_at
+	`
+	getRefreshTokenByHashQuery = `
+		SELECT id, user_id, family_id, token_hash, expires_at, used_at, revoked_at, created_at
+		FROM refresh_tokens
+		WHERE token_hash = $1
+	`
+	markRefreshTokenUsedQuery = `
+		UPDATE refresh_tokens
+		SET used_at = $2
+		WHERE id = $1 AND used_at IS NULL AND revoked_at IS NULL
+	`
+	revokeRefreshTokenFamilyQuery = `
+		UPDATE refresh_tokens
+		SET revoked_at = $2
+		WHERE family_id = $1 AND revoked_at IS NULL
+	`
+	revokeRefreshTokensByUserQuery = `
+		UPDATE refresh_tokens
+		SET revoked_at = $2
+		WHERE user_id = $1 AND revoked_at IS NULL
+	`
+)
@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/domain/entity"
+	"collabotask/internal/domain/repository"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RefreshTokenRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewRefreshTokenRepository(db *pgxpool.Pool) repository.RefreshTokenRepository {
+	return &RefreshTokenRepositoryImpl{db: db}
+}
+
+func (rr *RefreshTokenRepositoryImpl) Create(ctx context.Context, token *entity.RefreshToken) error {
+	return createRefreshToken(ctx, rr.db, token)
+}
+
+func (rr *RefreshTokenRepositoryImpl) GetByTokenHash(ctx context.Context, tokenHash string) (*entity.RefreshToken, error) {
+	token := &entity.RefreshToken{}
+	err := rr.db.QueryRow(ctx, getRefreshTokenByHashQuery, tokenHash).Scan(
+		&token.ID,
+		&token.UserID,
+		&token.FamilyID,
+		&token.TokenHash,
+		&token.ExpiresAt,
+		&token.UsedAt,
+		&token.RevokedAt,
+		&token.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrInvalidRefreshToken
+		}
+		return nil, fmt.Errorf("failed to get refresh token: %w", err)
+	}
+
+	return token, nil
+}
+
+func (rr *RefreshTokenRepositoryImpl) Rotate(ctx context.Context, usedTokenID uuid.UUID, newToken *entity.RefreshToken) error {
+	tx, err := rr.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin rotate refresh token transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, markRefreshTokenUsedQuery, usedTokenID, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to mark refresh token as used: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return domain.ErrRefreshTokenReused
+	}
+
+	if err := createRefreshToken(ctx, tx, newToken); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit rotate refresh token transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (rr *RefreshTokenRepositoryImpl) RevokeFamily(ctx context.Context, familyID uuid.UUID) error {
+	_, err := rr.db.Exec(ctx, revokeRefreshTokenFamilyQuery, familyID, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to revoke refresh token family: %w", err)
+	}
+
+	return nil
+}
+
+func (rr *RefreshTokenRepositoryImpl) RevokeAllByUser(ctx context.Context, userID uuid.UUID) error {
+	_, err := rr.db.Exec(ctx, revokeRefreshTokensByUserQuery, userID, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to revoke user refresh tokens: %w", err)
+	}
+
+	return nil
+}
+
+type refreshTokenQuerier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+func createRefreshToken(ctx context.Context, q refreshTokenQuerier, token *entity.RefreshToken) error {
+	err := q.QueryRow(
+		ctx,
+		createRefreshTokenQuery,
+		token.UserID,
+		token.FamilyID,
+		token.TokenHash,
+		token.ExpiresAt,
+		time.Now().UTC(),
+	).Scan(
+		&token.ID,
+		&token.UserID,
+		&token.FamilyID,
+		&token.TokenHash,
+		&token.ExpiresAt,
+		&token.UsedAt,
+		&token.RevokedAt,
+		&token.CreatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return domain.ErrConstraintViolation
+		}
+		return fmt.Errorf("failed to create refresh token: %w", err)
+	}
+
+	return nil
+}
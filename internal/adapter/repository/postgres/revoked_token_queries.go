@@ -0,0 +1,16 @@
+package postgres
+
+const (
+	revokeTokenQuery = `
+		INSERT INTO revoked_tokens (jti, user_id, expires_at, revoked_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (jti) DO NOTHING
+	`
+	isTokenRevokedQuery = `
+		SELECT EXISTS(
+			SELECT 1
+			FROM revoked_tokens
+			WHERE jti = $1
+		)
+	`
+)
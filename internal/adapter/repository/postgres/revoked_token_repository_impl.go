@@ -0,0 +1,38 @@
+package postgres
+
+import (
+	"collabotask/internal/domain/repository"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RevokedTokenRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewRevokedTokenRepository(db *pgxpool.Pool) repository.RevokedTokenRepository {
+	return &RevokedTokenRepositoryImpl{db: db}
+}
+
+func (rr *RevokedTokenRepositoryImpl) Revoke(ctx context.Context, tokenID string, userID uuid.UUID, expiresAt time.Time) error {
+	_, err := rr.db.Exec(ctx, revokeTokenQuery, tokenID, userID, expiresAt.UTC(), time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to revoke token: %w", err)
+	}
+
+	return nil
+}
+
+func (rr *RevokedTokenRepositoryImpl) IsRevoked(ctx context.Context, tokenID string) (bool, error) {
+	var revoked bool
+	err := rr.db.QueryRow(ctx, isTokenRevokedQuery, tokenID).Scan(&revoked)
+	if err != nil {
+		return false, fmt.Errorf("failed to check token revocation: %w", err)
+	}
+
+	return revoked, nil
+}
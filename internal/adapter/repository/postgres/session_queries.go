@@ -0,0 +1,39 @@
+package postgres
+
+const (
+	touchSessionQuery = `
+		INSERT INTO sessions (id, user_id, user_agent, ip_address, created_at, last_seen_at)
+		VALUES ($1, $2, $3, $4, $5, $5)
+		ON CONFLICT (id) DO UPDATE
+		SET user_agent = CASE WHEN EXCLUDED.user_agent = '' THEN sessions.user_agent ELSE EXCLUDED.user_agent END,
+			ip_address = CASE WHEN EXCLUDED.ip_address = '' THEN sessions.ip_address ELSE EXCLUDED.ip_address END,
+			last_seen_at = EXCLUDED.last_seen_at
+		WHERE sessions.revoked_at IS NULL AND sessions.user_id = EXCLUDED.user_id
+		RETURNING id, user_id, user_agent, ip_address, created_at, last_seen_at, revoked_at
+	`
+	getSessionByIDQuery = `
+		SELECT id, user_id, user_agent, ip_address, created_at, last_seen_at, revoked_at
+		FROM sessions
+		WHERE id = $1
+	`
+	listActiveSessionsByUserQuery = `
+		SELECT id, user_id, user_agent, ip_address, created_at, last_seen_at, revoked_at
+		FROM sessions
+		WHERE user_id = $1 AND revoked_at IS NULL AND last_seen_at >= $2
+		ORDER BY last_seen_at DESC
+	`
+	revokeSessionQuery = `
+		UPDATE sessions
+		SET revoked_at = $3
+		WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL
+	`
+	revokeSessionsByUserQuery = `
+		UPDATE sessions
+		SET revoked_at = $2
+		WHERE user_id = $1 AND revoked_at IS NULL
+	`
+	deleteUserSessionsQuery = `
+		DELETE FROM sessions
+		WHERE user_id = $1
+	`
+)
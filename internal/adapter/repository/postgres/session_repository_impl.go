@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/domain/entity"
+	"collabotask/internal/domain/repository"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SessionRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewSessionRepository(db *pgxpool.Pool) repository.SessionRepository {
+	return &SessionRepositoryImpl{db: db}
+}
+
+func (sr *SessionRepositoryImpl) Touch(ctx context.Context, session *entity.Session) error {
+	err := scanSession(sr.db.QueryRow(
+		ctx,
+		touchSessionQuery,
+		session.ID,
+		session.UserID,
+		session.UserAgent,
+		session.IPAddress,
+		time.Now().UTC(),
+	), session)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrSessionRevoked
+		}
+		return fmt.Errorf("failed to record session: %w", err)
+	}
+
+	return nil
+}
+
+func (sr *SessionRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.Session, error) {
+	session := &entity.Session{}
+	if err := scanSession(sr.db.QueryRow(ctx, getSessionByIDQuery, id), session); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrSessionNotFound
+		}
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+
+	return session, nil
+}
+
+func (sr *SessionRepositoryImpl) ListActiveByUser(ctx context.Context, userID uuid.UUID, seenSince time.Time) ([]*entity.Session, error) {
+	rows, err := sr.db.Query(ctx, listActiveSessionsByUserQuery, userID, seenSince.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+	defer rows.Close()
+
+	sessions := make([]*entity.Session, 0)
+	for rows.Next() {
+		session := &entity.Session{}
+		if err := scanSession(rows, session); err != nil {
+			return nil, fmt.Errorf("failed to scan session: %w", err)
+		}
+		sessions = append(sessions, session)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate sessions: %w", err)
+	}
+
+	return sessions, nil
+}
+
+func (sr *SessionRepositoryImpl) Revoke(ctx context.Context, id, userID uuid.UUID) error {
+	result, err := sr.db.Exec(ctx, revokeSessionQuery, id, userID, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrSessionNotFound
+	}
+
+	return nil
+}
+
+func (sr *SessionRepositoryImpl) RevokeAllByUser(ctx context.Context, userID uuid.UUID) error {
+	if _, err := sr.db.Exec(ctx, revokeSessionsByUserQuery, userID, time.Now().UTC()); err != nil {
+		return fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	return nil
+}
+
+func scanSession(row pgx.Row, session *entity.Session) error {
+	return row.Scan(
+		&session.ID,
+		&session.UserID,
+		&session.UserAgent,
+		&session.IPAddress,
+		&session.CreatedAt,
+		&session.LastSeenAt,
+		&session.RevokedAt,
+	)
+}
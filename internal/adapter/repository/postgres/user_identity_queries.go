@@ -0,0 +1,23 @@
+package postgres
+
+const (
+	createUserIdentityQuery = `
+		INSERT INTO user_identities (user_id, issuer, subject, email, last_login_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $5)
+		RETURNING id, user_id, issuer, subject, email, last_login_at, created_at
+	`
+	getUserIdentityBySubjectQuery = `
+		SELECT id, user_id, issuer, subject, email, last_login_at, created_at
+		FROM user_identities
+		WHERE issuer = $1 AND subject = $2
+	`
+	touchUserIdentityLastLoginQuery = `
+		UPDATE user_identities
+		SET last_login_at = $2
+		WHERE id = $1
+	`
+	deleteUserIdentitiesByUserQuery = `
+		DELETE FROM user_identities
+		WHERE user_id = $1
+	`
+)
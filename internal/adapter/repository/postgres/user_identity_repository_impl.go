@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/domain/entity"
+	"collabotask/internal/domain/repository"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type UserIdentityRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewUserIdentityRepository(db *pgxpool.Pool) repository.UserIdentityRepository {
+	return &UserIdentityRepositoryImpl{db: db}
+}
+
+func (ir *UserIdentityRepositoryImpl) Create(ctx context.Context, identity *entity.UserIdentity) error {
+	err := ir.db.QueryRow(
+		ctx,
+		createUserIdentityQuery,
+		identity.UserID,
+		identity.Issuer,
+		identity.Subject,
+		identity.Email,
+		time.Now().UTC(),
+	).Scan(
+		&identity.ID,
+		&identity.UserID,
+		&identity.Issuer,
+		&identity.Subject,
+		&identity.Email,
+		&identity.LastLoginAt,
+		&identity.CreatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return domain.ErrConstraintViolation
+		}
+		return fmt.Errorf("failed to create user identity: %w", err)
+	}
+
+	return nil
+}
+
+func (ir *UserIdentityRepositoryImpl) GetBySubject(ctx context.Context, issuer, subject string) (*entity.UserIdentity, error) {
+	identity := &entity.UserIdentity{}
+	err := ir.db.QueryRow(ctx, getUserIdentityBySubjectQuery, issuer, subject).Scan(
+		&identity.ID,
+		&identity.UserID,
+		&identity.Issuer,
+		&identity.Subject,
+		&identity.Email,
+		&identity.LastLoginAt,
+		&identity.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrUserIdentityNotFound
+		}
+		return nil, fmt.Errorf("failed to get user identity: %w", err)
+	}
+
+	return identity, nil
+}
+
+func (ir *UserIdentityRepositoryImpl) TouchLastLogin(ctx context.Context, id uuid.UUID) error {
+	if _, err := ir.db.Exec(ctx, touchUserIdentityLastLoginQuery, id, time.Now().UTC()); err != nil {
+		return fmt.Errorf("failed to update user identity: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package postgres
+
+const (
+	upsertPendingUserMFAQuery = `
+		INSERT INTO user_mfa (user_id, secret, confirmed_at, last_used_counter, created_at, updated_at)
+		VALUES ($1, $2, NULL, 0, $3, $3)
+		ON CONFLICT (user_id) DO UPDATE
+		SET secret = EXCLUDED.secret, last_used_counter = 0, updated_at = EXCLUDED.updated_at
+		WHERE user_mfa.confirmed_at IS NULL
+		RETURNING user_id, secret, confirmed_at, last_used_counter, created_at, updated_at
+	`
+	getUserMFAByUserIDQuery = `
+		SELECT user_id, secret, confirmed_at, last_used_counter, created_at, updated_at
+		FROM user_mfa
+		WHERE user_id = $1
+	`
+	confirmUserMFAQuery = `
+		UPDATE user_mfa
+		SET confirmed_at = $3, last_used_counter = $2, updated_at = $3
+		WHERE user_id = $1 AND confirmed_at IS NULL
+	`
+	advanceUserMFACounterQuery = `
+		UPDATE user_mfa
+		SET last_used_counter = $2, updated_at = $3
+		WHERE user_id = $1 AND last_used_counter < $2
+	`
+	deleteUserMFAQuery = `
+		DELETE FROM user_mfa
+		WHERE user_id = $1
+	`
+	deleteMFARecoveryCodesByUserQuery = `
+		DELETE FROM mfa_recovery_codes
+		WHERE user_id = $1
+	`
+	insertMFARecoveryCodeQuery = `
+		INSERT INTO mfa_recovery_codes (user_id, code_hash, created_at)
+		VALUES ($1, $2, $3)
+	`
+	useMFARecoveryCodeQuery = `
+		UPDATE mfa_recovery_codes
+		SET used_at = $3
+		WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL
+	`
+	countUnusedMFARecoveryCodesQuery = `
+		SELECT COUNT(*)
+		FROM mfa_recovery_codes
+		WHERE user_id = $1 AND used_at IS NULL
+	`
+	deleteMFAChallengesByUserQuery = `
+		DELETE FROM mfa_challenges
+		WHERE user_id = $1
+	`
+)
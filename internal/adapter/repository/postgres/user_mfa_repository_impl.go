@@ -0,0 +1,185 @@
+package postgres
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/domain/entity"
+	"collabotask/internal/domain/repository"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type UserMFARepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewUserMFARepository(db *pgxpool.Pool) repository.UserMFARepository {
+	return &UserMFARepositoryImpl{db: db}
+}
+
+func (mr *UserMFARepositoryImpl) UpsertPending(ctx context.Context, mfa *entity.UserMFA) error {
+	err := mr.db.QueryRow(ctx, upsertPendingUserMFAQuery, mfa.UserID, mfa.Secret, time.Now().UTC()).Scan(
+		&mfa.UserID,
+		&mfa.Secret,
+		&mfa.ConfirmedAt,
+		&mfa.LastUsedCounter,
+		&mfa.CreatedAt,
+		&mfa.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrMFAAlreadyEnabled
+		}
+		return fmt.Errorf("failed to store mfa secret: %w", err)
+	}
+
+	return nil
+}
+
+func (mr *UserMFARepositoryImpl) GetByUserID(ctx context.Context, userID uuid.UUID) (*entity.UserMFA, error) {
+	mfa := &entity.UserMFA{}
+	err := mr.db.QueryRow(ctx, getUserMFAByUserIDQuery, userID).Scan(
+		&mfa.UserID,
+		&mfa.Secret,
+		&mfa.ConfirmedAt,
+		&mfa.LastUsedCounter,
+		&mfa.CreatedAt,
+		&mfa.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrMFANotEnabled
+		}
+		return nil, fmt.Errorf("failed to get mfa settings: %w", err)
+	}
+
+	return mfa, nil
+}
+
+func (mr *UserMFARepositoryImpl) Confirm(ctx context.Context, userID uuid.UUID, counter int64, confirmedAt time.Time, recoveryCodeHashes []string) error {
+	tx, err := mr.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, confirmUserMFAQuery, userID, counter, confirmedAt)
+	if err != nil {
+		return fmt.Errorf("failed to confirm mfa: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return domain.ErrMFAAlreadyEnabled
+	}
+
+	if err := replaceRecoveryCodes(ctx, tx, userID, recoveryCodeHashes, confirmedAt); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (mr *UserMFARepositoryImpl) AdvanceCounter(ctx context.Context, userID uuid.UUID, counter int64) error {
+	result, err := mr.db.Exec(ctx, advanceUserMFACounterQuery, userID, counter, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to update mfa counter: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrInvalidMFACode
+	}
+
+	return nil
+}
+
+func (mr *UserMFARepositoryImpl) ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, recoveryCodeHashes []string) error {
+	tx, err := mr.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := replaceRecoveryCodes(ctx, tx, userID, recoveryCodeHashes, time.Now().UTC()); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (mr *UserMFARepositoryImpl) UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) error {
+	result, err := mr.db.Exec(ctx, useMFARecoveryCodeQuery, userID, codeHash, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to use recovery code: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrInvalidMFACode
+	}
+
+	return nil
+}
+
+func (mr *UserMFARepositoryImpl) CountUnusedRecoveryCodes(ctx context.Context, userID uuid.UUID) (int, error) {
+	var count int
+	if err := mr.db.QueryRow(ctx, countUnusedMFARecoveryCodesQuery, userID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count recovery codes: %w", err)
+	}
+
+	return count, nil
+}
+
+func (mr *UserMFARepositoryImpl) Delete(ctx context.Context, userID uuid.UUID) error {
+	tx, err := mr.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, deleteUserMFAQuery, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete mfa settings: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return domain.ErrMFANotEnabled
+	}
+
+	if _, err := tx.Exec(ctx, deleteMFARecoveryCodesByUserQuery, userID); err != nil {
+		return fmt.Errorf("failed to delete recovery codes: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, deleteMFAChallengesByUserQuery, userID); err != nil {
+		return fmt.Errorf("failed to delete mfa challenges: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func replaceRecoveryCodes(ctx context.Context, tx pgx.Tx, userID uuid.UUID, codeHashes []string, createdAt time.Time) error {
+	if _, err := tx.Exec(ctx, deleteMFARecoveryCodesByUserQuery, userID); err != nil {
+		return fmt.Errorf("failed to delete recovery codes: %w", err)
+	}
+
+	for _, hash := range codeHashes {
+		if _, err := tx.Exec(ctx, insertMFARecoveryCodeQuery, userID, hash, createdAt); err != nil {
+			return fmt.Errorf("failed to insert recovery code: %w", err)
+		}
+	}
+
+	return nil
+}
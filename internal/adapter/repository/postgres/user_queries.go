@@ -4,20 +4,20 @@ const (
 	createUserQuery = `
 		INSERT INTO users (email, password_hash, name, system_role, avatar_url, created_at, updated_at)
 		VALUES ($1, $2, $3, $4, $5, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
-		RETURNING id, email, name, avatar_url, system_role, created_at, updated_at
+		RETURNING id, email, name, avatar_url, system_role, email_verified_at, email_verified_backfilled, suspended_at, password_reset_required, created_at, updated_at
 	`
 	getUserByIdQuery = `
-		SELECT id, email, name, password_hash, avatar_url, system_role, created_at, updated_at
+		SELECT id, email, name, password_hash, avatar_url, system_role, email_verified_at, email_verified_backfilled, suspended_at, password_reset_required, created_at, updated_at
 		FROM users
 		WHERE id = $1
 	`
 	getUsersByIdsQuery = `
-		SELECT id, email, name, avatar_url, system_role, created_at, updated_at
+		SELECT id, email, name, avatar_url, system_role, email_verified_at, email_verified_backfilled, suspended_at, password_reset_required, created_at, updated_at
 		FROM users
 		WHERE id = ANY($1::uuid[])
 	`
 	getUserByEmailQuery = `
-		SELECT id, email, name, password_hash, avatar_url, system_role, created_at, updated_at
+		SELECT id, email, name, password_hash, avatar_url, system_role, email_verified_at, email_verified_backfilled, suspended_at, password_reset_required, created_at, updated_at
 		FROM users
 		WHERE email = $1
 	`
@@ -26,21 +26,30 @@ const (
 		SET
 			email = COALESCE($1, email),
 			name = COALESCE($2, name),
-			avatar_url = COALESCE($3, avatar_url),
+			avatar_url = CASE WHEN $3::text = '' THEN NULL ELSE COALESCE($3, avatar_url) END,
 			password_hash = COALESCE($4, password_hash),
+			password_reset_required = CASE WHEN $4 IS NULL THEN password_reset_required ELSE FALSE END,
 			updated_at = $5
 		WHERE id = $6
-		RETURNING id, email, name, avatar_url, system_role, created_at, updated_at
+		RETURNING id, email, name, avatar_url, system_role, email_verified_at, email_verified_backfilled, suspended_at, password_reset_required, created_at, updated_at
 	`
 	deleteUserQuery = `
 		DELETE FROM users
 		WHERE id = $1
 	`
 	listUsersQuery = `
-		SELECT id, email, name, avatar_url, system_role, created_at, updated_at
+		SELECT id, email, name, avatar_url, system_role, email_verified_at, email_verified_backfilled, suspended_at, password_reset_required, created_at, updated_at
 		FROM users
+		WHERE deleted_at IS NULL
+			AND ($1 = '' OR email ILIKE $1 || '%' OR name ILIKE '%' || $1 || '%')
 		ORDER BY created_at DESC
-		LIMIT $1 OFFSET $2
+		LIMIT $2 OFFSET $3
+	`
+	countUsersQuery = `
+		SELECT COUNT(*)
+		FROM users
+		WHERE deleted_at IS NULL
+			AND ($1 = '' OR email ILIKE $1 || '%' OR name ILIKE '%' || $1 || '%')
 	`
 	existsUserByEmailQuery = `
 		SELECT EXISTS(
@@ -49,4 +58,142 @@ const (
 			WHERE email = $1
 		)
 	`
+	getUserTokensValidAfterQuery = `
+		SELECT tokens_valid_after
+		FROM users
+		WHERE id = $1
+	`
+	setUserTokensValidAfterQuery = `
+		UPDATE users
+		SET tokens_valid_after = $1
+		WHERE id = $2
+	`
+	markUserEmailVerifiedQuery = `
+		UPDATE users
+		SET
+			email_verified_at = CASE WHEN email_verified_backfilled THEN $1 ELSE COALESCE(email_verified_at, $1) END,
+			email_verified_backfilled = FALSE
+		WHERE id = $2
+	`
+
+	lockActiveUserQuery = `
+		SELECT id
+		FROM users
+		WHERE id = $1 AND deleted_at IS NULL
+		FOR UPDATE
+	`
+	getOwnedWorkspaceIdsQuery = `
+		SELECT id
+		FROM workspaces
+		WHERE owner_id = $1
+		FOR UPDATE
+	`
+	getWorkspaceSuccessorQuery = `
+		SELECT user_id
+		FROM workspace_members
+		WHERE workspace_id = $1 AND user_id <> $2
+		ORDER BY CASE role WHEN 'ADMIN' THEN 0 ELSE 1 END, joined_at, user_id
+		LIMIT 1
+	`
+	transferWorkspaceOwnerQuery = `
+		UPDATE workspaces
+		SET owner_id = $1, updated_at = $2
+		WHERE id = $3
+	`
+	promoteWorkspaceSuccessorQuery = `
+		UPDATE workspace_members
+		SET role = 'ADMIN'
+		WHERE workspace_id = $1 AND user_id = $2
+	`
+	deleteOwnedWorkspaceQuery = `
+		DELETE FROM workspaces
+		WHERE id = $1
+	`
+	getCreatedBoardsQuery = `
+		SELECT b.id, w.owner_id
+		FROM boards b
+		JOIN workspaces w ON w.id = b.workspace_id
+		WHERE b.created_by = $1
+		FOR UPDATE OF b
+	`
+	getBoardSuccessorQuery = `
+		SELECT user_id
+		FROM board_members
+		WHERE board_id = $1 AND user_id <> $2
+		ORDER BY CASE role WHEN 'BOARD_OWNER' THEN 0 ELSE 1 END, joined_at, user_id
+		LIMIT 1
+	`
+	transferBoardCreatorQuery = `
+		UPDATE boards
+		SET created_by = $1, updated_at = $2
+		WHERE id = $3
+	`
+	upsertBoardOwnerQuery = `
+		INSERT INTO board_members (board_id, user_id, role, joined_at)
+		VALUES ($1, $2, 'BOARD_OWNER', $3)
+		ON CONFLICT (board_id, user_id) DO UPDATE SET role = 'BOARD_OWNER', added_via_group = FALSE
+	`
+	reassignCreatedCardsQuery = `
+		UPDATE cards c
+		SET created_by = b.created_by
+		FROM columns col
+		JOIN boards b ON b.id = col.board_id
+		WHERE c.column_id = col.id AND c.created_by = $1
+	`
+	unassignUserCardsQuery = `
+		UPDATE cards
+		SET assigned_to = NULL
+		WHERE assigned_to = $1
+	`
+	deleteUserBoardMembershipsQuery = `
+		DELETE FROM board_members
+		WHERE user_id = $1
+	`
+	deleteUserWorkspaceMembershipsQuery = `
+		DELETE FROM workspace_members
+		WHERE user_id = $1
+	`
+	deleteUserRefreshTokensQuery = `
+		DELETE FROM refresh_tokens
+		WHERE user_id = $1
+	`
+	deleteUserPasswordResetTokensQuery = `
+		DELETE FROM password_reset_tokens
+		WHERE user_id = $1
+	`
+	deleteUserEmailVerificationTokensQuery = `
+		DELETE FROM email_verification_tokens
+		WHERE user_id = $1
+	`
+	deleteUserPersonalAccessTokensQuery = `
+		DELETE FROM personal_access_tokens
+		WHERE user_id = $1
+	`
+	anonymizeUserQuery = `
+		UPDATE users
+		SET
+			email = 'deleted-' || id || '@deleted.invalid',
+			name = 'Deleted user',
+			password_hash = '',
+			avatar_url = NULL,
+			system_role = 'USER',
+			email_verified_at = NULL,
+			email_verified_backfilled = FALSE,
+			suspended_at = NULL,
+			password_reset_required = FALSE,
+			tokens_valid_after = $1,
+			deleted_at = $1,
+			updated_at = $1
+		WHERE id = $2
+	`
+	setUserSuspendedAtQuery = `
+		UPDATE users
+		SET suspended_at = $1, updated_at = $2
+		WHERE id = $3 AND deleted_at IS NULL
+	`
+	setUserPasswordResetRequiredQuery = `
+		UPDATE users
+		SET password_reset_required = $1, updated_at = $2
+		WHERE id = $3 AND deleted_at IS NULL
+	`
 )
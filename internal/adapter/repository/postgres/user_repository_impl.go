@@ -7,6 +7,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -42,6 +44,10 @@ func (r *UserRepositoryImpl) Create(ctx context.Context, user *entity.User) erro
 		&user.Name,
 		&user.AvatarURL,
 		&user.SystemRole,
+		&user.EmailVerifiedAt,
+		&user.EmailVerifiedBackfilled,
+		&user.SuspendedAt,
+		&user.PasswordResetRequired,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -71,6 +77,10 @@ func (r *UserRepositoryImpl) GetById(ctx context.Context, id uuid.UUID) (*entity
 		&user.PasswordHash,
 		&avatarURL,
 		&user.SystemRole,
+		&user.EmailVerifiedAt,
+		&user.EmailVerifiedBackfilled,
+		&user.SuspendedAt,
+		&user.PasswordResetRequired,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -108,6 +118,10 @@ func (r *UserRepositoryImpl) GetByIds(ctx context.Context, ids []uuid.UUID) (map
 			&user.Name,
 			&avatarURL,
 			&user.SystemRole,
+			&user.EmailVerifiedAt,
+			&user.EmailVerifiedBackfilled,
+			&user.SuspendedAt,
+			&user.PasswordResetRequired,
 			&user.CreatedAt,
 			&user.UpdatedAt,
 		)
@@ -137,6 +151,10 @@ func (r *UserRepositoryImpl) GetByEmail(ctx context.Context, email string) (*ent
 		&user.PasswordHash,
 		&avatarURL,
 		&user.SystemRole,
+		&user.EmailVerifiedAt,
+		&user.EmailVerifiedBackfilled,
+		&user.SuspendedAt,
+		&user.PasswordResetRequired,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -153,11 +171,6 @@ func (r *UserRepositoryImpl) GetByEmail(ctx context.Context, email string) (*ent
 }
 
 func (r *UserRepositoryImpl) Update(ctx context.Context, user *entity.User) error {
-	var avatarURL *string
-	if user.AvatarURL != nil && *user.AvatarURL != "" {
-		avatarURL = user.AvatarURL
-	}
-
 	var email *string
 	if user.Email != "" {
 		email = &user.Email
@@ -178,7 +191,7 @@ func (r *UserRepositoryImpl) Update(ctx context.Context, user *entity.User) erro
 		updateUserQuery,
 		email,
 		name,
-		avatarURL,
+		user.AvatarURL,
 		passwordHash,
 		user.UpdatedAt,
 		user.ID,
@@ -188,6 +201,10 @@ func (r *UserRepositoryImpl) Update(ctx context.Context, user *entity.User) erro
 		&user.Name,
 		&user.AvatarURL,
 		&user.SystemRole,
+		&user.EmailVerifiedAt,
+		&user.EmailVerifiedBackfilled,
+		&user.SuspendedAt,
+		&user.PasswordResetRequired,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -223,8 +240,8 @@ func (r *UserRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
-func (r *UserRepositoryImpl) List(ctx context.Context, limit, offset int) ([]*entity.User, error) {
-	rows, err := r.db.Query(ctx, listUsersQuery, limit, offset)
+func (r *UserRepositoryImpl) List(ctx context.Context, search string, limit, offset int) ([]*entity.User, error) {
+	rows, err := r.db.Query(ctx, listUsersQuery, escapeLikePattern(search), limit, offset)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list users: %w", err)
 	}
@@ -241,6 +258,10 @@ func (r *UserRepositoryImpl) List(ctx context.Context, limit, offset int) ([]*en
 			&user.Name,
 			&avatarURL,
 			&user.SystemRole,
+			&user.EmailVerifiedAt,
+			&user.EmailVerifiedBackfilled,
+			&user.SuspendedAt,
+			&user.PasswordResetRequired,
 			&user.CreatedAt,
 			&user.UpdatedAt,
 		)
@@ -259,6 +280,15 @@ func (r *UserRepositoryImpl) List(ctx context.Context, limit, offset int) ([]*en
 	return users, nil
 }
 
+func (r *UserRepositoryImpl) Count(ctx context.Context, search string) (int, error) {
+	var count int
+	if err := r.db.QueryRow(ctx, countUsersQuery, escapeLikePattern(search)).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count users: %w", err)
+	}
+
+	return count, nil
+}
+
 func (r *UserRepositoryImpl) ExistsByEmail(ctx context.Context, email string) (bool, error) {
 	var exists bool
 	err := r.db.QueryRow(ctx, existsUserByEmailQuery, email).Scan(&exists)
@@ -268,3 +298,218 @@ func (r *UserRepositoryImpl) ExistsByEmail(ctx context.Context, email string) (b
 
 	return exists, nil
 }
+
+func (r *UserRepositoryImpl) GetTokensValidAfter(ctx context.Context, id uuid.UUID) (*time.Time, error) {
+	var validAfter *time.Time
+	err := r.db.QueryRow(ctx, getUserTokensValidAfterQuery, id).Scan(&validAfter)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get user tokens valid after: %w", err)
+	}
+
+	return validAfter, nil
+}
+
+func (r *UserRepositoryImpl) SetTokensValidAfter(ctx context.Context, id uuid.UUID, validAfter time.Time) error {
+	result, err := r.db.Exec(ctx, setUserTokensValidAfterQuery, validAfter.UTC(), id)
+	if err != nil {
+		return fmt.Errorf("failed to set user tokens valid after: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrUserNotFound
+	}
+
+	return nil
+}
+
+func (r *UserRepositoryImpl) MarkEmailVerified(ctx context.Context, id uuid.UUID, verifiedAt time.Time) error {
+	result, err := r.db.Exec(ctx, markUserEmailVerifiedQuery, verifiedAt.UTC(), id)
+	if err != nil {
+		return fmt.Errorf("failed to mark user email as verified: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrUserNotFound
+	}
+
+	return nil
+}
+
+func (r *UserRepositoryImpl) DeleteAccount(ctx context.Context, id uuid.UUID, deletedAt time.Time) (*entity.AccountDeletionSummary, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin delete account transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	deletedAt = deletedAt.UTC()
+	summary := &entity.AccountDeletionSummary{}
+
+	var lockedID uuid.UUID
+	if err := tx.QueryRow(ctx, lockActiveUserQuery, id).Scan(&lockedID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to lock user: %w", err)
+	}
+
+	workspaceIDs, err := collectUUIDs(ctx, tx, getOwnedWorkspaceIdsQuery, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get owned workspaces: %w", err)
+	}
+
+	for _, workspaceID := range workspaceIDs {
+		var successorID uuid.UUID
+		err := tx.QueryRow(ctx, getWorkspaceSuccessorQuery, workspaceID, id).Scan(&successorID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			if _, err := tx.Exec(ctx, deleteOwnedWorkspaceQuery, workspaceID); err != nil {
+				return nil, fmt.Errorf("failed to delete workspace: %w", err)
+			}
+			summary.DeletedWorkspaces++
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to find workspace successor: %w", err)
+		}
+
+		if _, err := tx.Exec(ctx, transferWorkspaceOwnerQuery, successorID, deletedAt, workspaceID); err != nil {
+			return nil, fmt.Errorf("failed to transfer workspace ownership: %w", err)
+		}
+		if _, err := tx.Exec(ctx, promoteWorkspaceSuccessorQuery, workspaceID, successorID); err != nil {
+			return nil, fmt.Errorf("failed to promote workspace successor: %w", err)
+		}
+		summary.TransferredWorkspaces++
+	}
+
+	// Workspace ownership is settled first so boards nobody else belongs to
+	// can fall back to the (new) workspace owner.
+	rows, err := tx.Query(ctx, getCreatedBoardsQuery, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get created boards: %w", err)
+	}
+	fallbackOwners := map[uuid.UUID]uuid.UUID{}
+	var boardIDs []uuid.UUID
+	for rows.Next() {
+		var boardID, workspaceOwnerID uuid.UUID
+		if err := rows.Scan(&boardID, &workspaceOwnerID); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan created board: %w", err)
+		}
+		boardIDs = append(boardIDs, boardID)
+		fallbackOwners[boardID] = workspaceOwnerID
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating created boards: %w", err)
+	}
+
+	for _, boardID := range boardIDs {
+		successorID := fallbackOwners[boardID]
+		err := tx.QueryRow(ctx, getBoardSuccessorQuery, boardID, id).Scan(&successorID)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("failed to find board successor: %w", err)
+		}
+
+		if _, err := tx.Exec(ctx, transferBoardCreatorQuery, successorID, deletedAt, boardID); err != nil {
+			return nil, fmt.Errorf("failed to transfer board ownership: %w", err)
+		}
+		if _, err := tx.Exec(ctx, upsertBoardOwnerQuery, boardID, successorID, deletedAt); err != nil {
+			return nil, fmt.Errorf("failed to promote board successor: %w", err)
+		}
+		summary.TransferredBoards++
+	}
+
+	result, err := tx.Exec(ctx, reassignCreatedCardsQuery, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reassign created cards: %w", err)
+	}
+	summary.ReassignedCards = int(result.RowsAffected())
+
+	for _, query := range []string{
+		unassignUserCardsQuery,
+		deleteUserBoardMembershipsQuery,
+		deleteUserWorkspaceMembershipsQuery,
+		deleteUserRefreshTokensQuery,
+		deleteUserPasswordResetTokensQuery,
+		deleteUserEmailVerificationTokensQuery,
+		deleteUserPersonalAccessTokensQuery,
+		deleteUserMFAQuery,
+		deleteMFARecoveryCodesByUserQuery,
+		deleteMFAChallengesByUserQuery,
+		deleteUserIdentitiesByUserQuery,
+		deleteUserSessionsQuery,
+	} {
+		if _, err := tx.Exec(ctx, query, id); err != nil {
+			return nil, fmt.Errorf("failed to clean up user data: %w", err)
+		}
+	}
+
+	if _, err := tx.Exec(ctx, anonymizeUserQuery, deletedAt, id); err != nil {
+		return nil, fmt.Errorf("failed to anonymise user: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return summary, nil
+}
+
+func (r *UserRepositoryImpl) SetSuspended(ctx context.Context, id uuid.UUID, suspendedAt *time.Time) error {
+	if suspendedAt != nil {
+		at := suspendedAt.UTC()
+		suspendedAt = &at
+	}
+
+	result, err := r.db.Exec(ctx, setUserSuspendedAtQuery, suspendedAt, time.Now().UTC(), id)
+	if err != nil {
+		return fmt.Errorf("failed to set user suspension: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrUserNotFound
+	}
+
+	return nil
+}
+
+func (r *UserRepositoryImpl) SetPasswordResetRequired(ctx context.Context, id uuid.UUID, required bool) error {
+	result, err := r.db.Exec(ctx, setUserPasswordResetRequiredQuery, required, time.Now().UTC(), id)
+	if err != nil {
+		return fmt.Errorf("failed to set password reset requirement: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrUserNotFound
+	}
+
+	return nil
+}
+
+func collectUUIDs(ctx context.Context, tx pgx.Tx, query string, args ...any) ([]uuid.UUID, error) {
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// escapeLikePattern escapes LIKE wildcards so user supplied search terms are
+// matched literally.
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(strings.TrimSpace(s))
+}
@@ -0,0 +1,73 @@
+package postgres
+
+const (
+	createWorkspaceEmailDomainQuery = `
+		INSERT INTO workspace_email_domains (workspace_id, domain, verification_token, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $5)
+		RETURNING id, created_at, updated_at
+	`
+	getWorkspaceEmailDomainQuery = `
+		SELECT id, workspace_id, domain, verification_token, created_by, verified_at, created_at, updated_at
+		FROM workspace_email_domains
+		WHERE id = $1 AND workspace_id = $2
+	`
+	lockWorkspaceEmailDomainQuery = `
+		SELECT id FROM workspace_email_domains
+		WHERE id = $1 AND workspace_id = $2
+		FOR UPDATE
+	`
+	listWorkspaceEmailDomainsQuery = `
+		SELECT id, workspace_id, domain, verification_token, created_by, verified_at, created_at, updated_at
+		FROM workspace_email_domains
+		WHERE workspace_id = $1
+		ORDER BY domain
+	`
+	listWorkspaceEmailDomainBoardsQuery = `
+		SELECT domain_id, board_id
+		FROM workspace_email_domain_boards
+		WHERE domain_id = ANY($1::uuid[])
+	`
+	deleteWorkspaceEmailDomainBoardsQuery = `
+		DELETE FROM workspace_email_domain_boards WHERE domain_id = $1
+	`
+	// Only boards of the domain's own workspace are linked; the caller
+	// compares the row count with what it asked for.
+	addWorkspaceEmailDomainBoardsQuery = `
+		INSERT INTO workspace_email_domain_boards (domain_id, board_id)
+		SELECT $1, b.id
+		FROM boards b
+		WHERE b.id = ANY($2::uuid[]) AND b.workspace_id = $3
+	`
+	markWorkspaceEmailDomainVerifiedQuery = `
+		UPDATE workspace_email_domains
+		SET verified_at = $3, updated_at = $3
+		WHERE id = $1 AND workspace_id = $2
+	`
+	deleteWorkspaceEmailDomainQuery = `
+		DELETE FROM workspace_email_domains WHERE id = $1 AND workspace_id = $2
+	`
+	recordWorkspaceDomainAutoJoinsQuery = `
+		INSERT INTO workspace_domain_auto_joins (workspace_id, user_id, joined_at)
+		SELECT d.workspace_id, $1, $3
+		FROM workspace_email_domains d
+		WHERE d.domain = $2 AND d.verified_at IS NOT NULL
+		ON CONFLICT (workspace_id, user_id) DO NOTHING
+		RETURNING workspace_id
+	`
+	addDomainWorkspaceMembersQuery = `
+		INSERT INTO workspace_members (workspace_id, user_id, role, joined_at)
+		SELECT ws.id, $1, 'MEMBER', $3
+		FROM unnest($2::uuid[]) AS ws(id)
+		ON CONFLICT (workspace_id, user_id) DO NOTHING
+		RETURNING workspace_id
+	`
+	addDomainDefaultBoardMembersQuery = `
+		INSERT INTO board_members (board_id, user_id, role, joined_at)
+		SELECT db.board_id, $1, 'BOARD_MEMBER', $4
+		FROM workspace_email_domains d
+		INNER JOIN workspace_email_domain_boards db ON db.domain_id = d.id
+		INNER JOIN boards b ON b.id = db.board_id AND b.is_archived = FALSE
+		WHERE d.domain = $2 AND d.verified_at IS NOT NULL AND d.workspace_id = ANY($3::uuid[])
+		ON CONFLICT (board_id, user_id) DO NOTHING
+	`
+)
@@ -0,0 +1,264 @@
+package postgres
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/domain/entity"
+	"collabotask/internal/domain/repository"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type WorkspaceEmailDomainRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewWorkspaceEmailDomainRepository(db *pgxpool.Pool) repository.WorkspaceEmailDomainRepository {
+	return &WorkspaceEmailDomainRepositoryImpl{db: db}
+}
+
+func (dr *WorkspaceEmailDomainRepositoryImpl) Create(ctx context.Context, emailDomain *entity.WorkspaceEmailDomain) error {
+	tx, err := dr.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin create email domain transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	err = tx.QueryRow(
+		ctx,
+		createWorkspaceEmailDomainQuery,
+		emailDomain.WorkspaceID,
+		emailDomain.Domain,
+		emailDomain.VerificationToken,
+		emailDomain.CreatedBy,
+		time.Now().UTC(),
+	).Scan(&emailDomain.ID, &emailDomain.CreatedAt, &emailDomain.UpdatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return domain.ErrEmailDomainAlreadyAdded
+		}
+		return fmt.Errorf("failed to create email domain: %w", err)
+	}
+
+	if err := linkDefaultBoards(ctx, tx, emailDomain.ID, emailDomain.WorkspaceID, emailDomain.DefaultBoardIDs); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (dr *WorkspaceEmailDomainRepositoryImpl) GetByID(ctx context.Context, id, workspaceID uuid.UUID) (*entity.WorkspaceEmailDomain, error) {
+	emailDomain, err := scanWorkspaceEmailDomain(dr.db.QueryRow(ctx, getWorkspaceEmailDomainQuery, id, workspaceID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrEmailDomainNotFound
+		}
+		return nil, fmt.Errorf("failed to get email domain: %w", err)
+	}
+
+	if err := dr.loadDefaultBoards(ctx, []*entity.WorkspaceEmailDomain{emailDomain}); err != nil {
+		return nil, err
+	}
+
+	return emailDomain, nil
+}
+
+func (dr *WorkspaceEmailDomainRepositoryImpl) ListByWorkspace(ctx context.Context, workspaceID uuid.UUID) ([]*entity.WorkspaceEmailDomain, error) {
+	rows, err := dr.db.Query(ctx, listWorkspaceEmailDomainsQuery, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list email domains: %w", err)
+	}
+	defer rows.Close()
+
+	domains := []*entity.WorkspaceEmailDomain{}
+	for rows.Next() {
+		emailDomain, err := scanWorkspaceEmailDomain(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan email domain: %w", err)
+		}
+		domains = append(domains, emailDomain)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating email domains: %w", err)
+	}
+
+	if err := dr.loadDefaultBoards(ctx, domains); err != nil {
+		return nil, err
+	}
+
+	return domains, nil
+}
+
+func (dr *WorkspaceEmailDomainRepositoryImpl) SetDefaultBoards(ctx context.Context, id, workspaceID uuid.UUID, boardIDs []uuid.UUID) error {
+	tx, err := dr.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin set default boards transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var lockedID uuid.UUID
+	if err := tx.QueryRow(ctx, lockWorkspaceEmailDomainQuery, id, workspaceID).Scan(&lockedID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrEmailDomainNotFound
+		}
+		return fmt.Errorf("failed to lock email domain: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, deleteWorkspaceEmailDomainBoardsQuery, id); err != nil {
+		return fmt.Errorf("failed to clear default boards: %w", err)
+	}
+	if err := linkDefaultBoards(ctx, tx, id, workspaceID, boardIDs); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (dr *WorkspaceEmailDomainRepositoryImpl) MarkVerified(ctx context.Context, id, workspaceID uuid.UUID, verifiedAt time.Time) error {
+	result, err := dr.db.Exec(ctx, markWorkspaceEmailDomainVerifiedQuery, id, workspaceID, verifiedAt.UTC())
+	if err != nil {
+		return fmt.Errorf("failed to mark email domain verified: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrEmailDomainNotFound
+	}
+
+	return nil
+}
+
+func (dr *WorkspaceEmailDomainRepositoryImpl) Delete(ctx context.Context, id, workspaceID uuid.UUID) error {
+	result, err := dr.db.Exec(ctx, deleteWorkspaceEmailDomainQuery, id, workspaceID)
+	if err != nil {
+		return fmt.Errorf("failed to delete email domain: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrEmailDomainNotFound
+	}
+
+	return nil
+}
+
+func (dr *WorkspaceEmailDomainRepositoryImpl) JoinUser(ctx context.Context, userID uuid.UUID, emailDomain string, joinedAt time.Time) (int, error) {
+	tx, err := dr.db.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin domain join transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	joinedAt = joinedAt.UTC()
+
+	workspaceIDs, err := collectUUIDs(ctx, tx, recordWorkspaceDomainAutoJoinsQuery, userID, emailDomain, joinedAt)
+	if err != nil {
+		return 0, fmt.Errorf("failed to record domain joins: %w", err)
+	}
+	if len(workspaceIDs) == 0 {
+		return 0, nil
+	}
+
+	// Users who already belonged to a workspace keep their role and boards.
+	joinedIDs, err := collectUUIDs(ctx, tx, addDomainWorkspaceMembersQuery, userID, workspaceIDs, joinedAt)
+	if err != nil {
+		return 0, fmt.Errorf("failed to add workspace memberships: %w", err)
+	}
+
+	if len(joinedIDs) > 0 {
+		if _, err := tx.Exec(ctx, addDomainDefaultBoardMembersQuery, userID, emailDomain, joinedIDs, joinedAt); err != nil {
+			return 0, fmt.Errorf("failed to add default board memberships: %w", err)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return len(joinedIDs), nil
+}
+
+func (dr *WorkspaceEmailDomainRepositoryImpl) loadDefaultBoards(ctx context.Context, domains []*entity.WorkspaceEmailDomain) error {
+	if len(domains) == 0 {
+		return nil
+	}
+
+	byID := make(map[uuid.UUID]*entity.WorkspaceEmailDomain, len(domains))
+	ids := make([]uuid.UUID, 0, len(domains))
+	for _, d := range domains {
+		d.DefaultBoardIDs = []uuid.UUID{}
+		byID[d.ID] = d
+		ids = append(ids, d.ID)
+	}
+
+	rows, err := dr.db.Query(ctx, listWorkspaceEmailDomainBoardsQuery, ids)
+	if err != nil {
+		return fmt.Errorf("failed to list default boards: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var domainID, boardID uuid.UUID
+		if err := rows.Scan(&domainID, &boardID); err != nil {
+			return fmt.Errorf("failed to scan default board: %w", err)
+		}
+		if d, ok := byID[domainID]; ok {
+			d.DefaultBoardIDs = append(d.DefaultBoardIDs, boardID)
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("error iterating default boards: %w", err)
+	}
+
+	return nil
+}
+
+func linkDefaultBoards(ctx context.Context, tx pgx.Tx, domainID, workspaceID uuid.UUID, boardIDs []uuid.UUID) error {
+	if len(boardIDs) == 0 {
+		return nil
+	}
+
+	result, err := tx.Exec(ctx, addWorkspaceEmailDomainBoardsQuery, domainID, boardIDs, workspaceID)
+	if err != nil {
+		return fmt.Errorf("failed to add default boards: %w", err)
+	}
+	if result.RowsAffected() != int64(len(boardIDs)) {
+		return domain.ErrBoardNotFound
+	}
+
+	return nil
+}
+
+func scanWorkspaceEmailDomain(row pgx.Row) (*entity.WorkspaceEmailDomain, error) {
+	emailDomain := &entity.WorkspaceEmailDomain{}
+	err := row.Scan(
+		&emailDomain.ID,
+		&emailDomain.WorkspaceID,
+		&emailDomain.Domain,
+		&emailDomain.VerificationToken,
+		&emailDomain.CreatedBy,
+		&emailDomain.VerifiedAt,
+		&emailDomain.CreatedAt,
+		&emailDomain.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return emailDomain, nil
+}
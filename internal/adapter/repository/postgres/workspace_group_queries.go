@@ -0,0 +1,90 @@
+package postgres
+
+const (
+	createWorkspaceGroupQuery = `
+		INSERT INTO workspace_groups (workspace_id, name, description, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $5)
+		RETURNING id, created_at, updated_at
+	`
+	getWorkspaceGroupQuery = `
+		SELECT id, workspace_id, name, description, created_by, created_at, updated_at
+		FROM workspace_groups
+		WHERE id = $1 AND workspace_id = $2
+	`
+	listWorkspaceGroupsQuery = `
+		SELECT g.id, g.workspace_id, g.name, g.description, g.created_by, g.created_at, g.updated_at,
+			COUNT(gm.user_id)::bigint AS member_count
+		FROM workspace_groups g
+		LEFT JOIN workspace_group_members gm ON gm.group_id = g.id
+		WHERE g.workspace_id = $1
+		GROUP BY g.id
+		ORDER BY lower(g.name)
+	`
+	updateWorkspaceGroupQuery = `
+		UPDATE workspace_groups
+		SET name = $3, description = $4, updated_at = $5
+		WHERE id = $1 AND workspace_id = $2
+		RETURNING updated_at
+	`
+	deleteWorkspaceGroupQuery = `
+		DELETE FROM workspace_groups WHERE id = $1 AND workspace_id = $2
+	`
+	listWorkspaceGroupMembersQuery = `
+		SELECT group_id, user_id, added_at
+		FROM workspace_group_members
+		WHERE group_id = $1
+		ORDER BY added_at ASC
+	`
+	// The composite foreign key to workspace_members rejects users outside
+	// the group's workspace.
+	addWorkspaceGroupMembersQuery = `
+		INSERT INTO workspace_group_members (group_id, workspace_id, user_id, added_at)
+		SELECT $1, $2, u.id, $4
+		FROM unnest($3::uuid[]) AS u(id)
+		ON CONFLICT (group_id, user_id) DO NOTHING
+		RETURNING user_id
+	`
+	addGroupMembersToLinkedBoardsQuery = `
+		INSERT INTO board_members (board_id, user_id, role, joined_at, added_via_group)
+		SELECT l.board_id, u.id, 'BOARD_MEMBER', $3, TRUE
+		FROM board_group_links l
+		CROSS JOIN unnest($2::uuid[]) AS u(id)
+		WHERE l.group_id = $1
+		ON CONFLICT (board_id, user_id) DO NOTHING
+	`
+	deleteWorkspaceGroupMemberQuery = `
+		DELETE FROM workspace_group_members WHERE group_id = $1 AND user_id = $2
+	`
+	// Only memberships granted by a group are removed, and only where no
+	// other group linked to the board still holds the user.
+	removeGroupMemberFromLinkedBoardsQuery = `
+		DELETE FROM board_members bm
+		USING board_group_links l
+		WHERE l.group_id = $1
+			AND bm.board_id = l.board_id
+			AND bm.user_id = $2
+			AND bm.added_via_group
+			AND bm.role = 'BOARD_MEMBER'
+			AND NOT EXISTS (
+				SELECT 1
+				FROM board_group_links l2
+				INNER JOIN workspace_group_members gm ON gm.group_id = l2.group_id
+				WHERE l2.board_id = bm.board_id AND l2.group_id <> $1 AND gm.user_id = $2
+			)
+	`
+	linkBoardGroupQuery = `
+		INSERT INTO board_group_links (board_id, group_id, linked_by, linked_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (board_id, group_id) DO NOTHING
+	`
+	addGroupMembersToBoardQuery = `
+		INSERT INTO board_members (board_id, user_id, role, joined_at, added_via_group)
+		SELECT $1, gm.user_id, 'BOARD_MEMBER', $3, $4
+		FROM workspace_group_members gm
+		WHERE gm.group_id = $2
+		ON CONFLICT (board_id, user_id) DO NOTHING
+	`
+	unlinkBoardGroupQuery = `
+		DELETE FROM board_group_links WHERE board_id = $1 AND group_id = $2
+	`
+)
@@ -0,0 +1,259 @@
+package postgres
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/domain/entity"
+	"collabotask/internal/domain/repository"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type WorkspaceGroupRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewWorkspaceGroupRepository(db *pgxpool.Pool) repository.WorkspaceGroupRepository {
+	return &WorkspaceGroupRepositoryImpl{db: db}
+}
+
+func (gr *WorkspaceGroupRepositoryImpl) Create(ctx context.Context, group *entity.WorkspaceGroup) error {
+	err := gr.db.QueryRow(
+		ctx,
+		createWorkspaceGroupQuery,
+		group.WorkspaceID,
+		group.Name,
+		group.Description,
+		group.CreatedBy,
+		time.Now().UTC(),
+	).Scan(&group.ID, &group.CreatedAt, &group.UpdatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return domain.ErrGroupNameTaken
+		}
+		return fmt.Errorf("failed to create group: %w", err)
+	}
+
+	return nil
+}
+
+func (gr *WorkspaceGroupRepositoryImpl) GetByID(ctx context.Context, id, workspaceID uuid.UUID) (*entity.WorkspaceGroup, error) {
+	group := &entity.WorkspaceGroup{}
+	err := gr.db.QueryRow(ctx, getWorkspaceGroupQuery, id, workspaceID).Scan(
+		&group.ID,
+		&group.WorkspaceID,
+		&group.Name,
+		&group.Description,
+		&group.CreatedBy,
+		&group.CreatedAt,
+		&group.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrGroupNotFound
+		}
+		return nil, fmt.Errorf("failed to get group: %w", err)
+	}
+
+	return group, nil
+}
+
+func (gr *WorkspaceGroupRepositoryImpl) ListByWorkspace(ctx context.Context, workspaceID uuid.UUID) ([]*entity.WorkspaceGroupListItem, error) {
+	rows, err := gr.db.Query(ctx, listWorkspaceGroupsQuery, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list groups: %w", err)
+	}
+	defer rows.Close()
+
+	groups := []*entity.WorkspaceGroupListItem{}
+	for rows.Next() {
+		group := &entity.WorkspaceGroupListItem{}
+		var memberCount int64
+		err := rows.Scan(
+			&group.ID,
+			&group.WorkspaceID,
+			&group.Name,
+			&group.Description,
+			&group.CreatedBy,
+			&group.CreatedAt,
+			&group.UpdatedAt,
+			&memberCount,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan group: %w", err)
+		}
+
+		group.MemberCount = uint(memberCount)
+		groups = append(groups, group)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating groups: %w", err)
+	}
+
+	return groups, nil
+}
+
+func (gr *WorkspaceGroupRepositoryImpl) Update(ctx context.Context, group *entity.WorkspaceGroup) error {
+	err := gr.db.QueryRow(
+		ctx,
+		updateWorkspaceGroupQuery,
+		group.ID,
+		group.WorkspaceID,
+		group.Name,
+		group.Description,
+		time.Now().UTC(),
+	).Scan(&group.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrGroupNotFound
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return domain.ErrGroupNameTaken
+		}
+		return fmt.Errorf("failed to update group: %w", err)
+	}
+
+	return nil
+}
+
+func (gr *WorkspaceGroupRepositoryImpl) Delete(ctx context.Context, id, workspaceID uuid.UUID) error {
+	result, err := gr.db.Exec(ctx, deleteWorkspaceGroupQuery, id, workspaceID)
+	if err != nil {
+		return fmt.Errorf("failed to delete group: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrGroupNotFound
+	}
+
+	return nil
+}
+
+func (gr *WorkspaceGroupRepositoryImpl) ListMembers(ctx context.Context, groupID uuid.UUID) ([]*entity.WorkspaceGroupMember, error) {
+	rows, err := gr.db.Query(ctx, listWorkspaceGroupMembersQuery, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list group members: %w", err)
+	}
+	defer rows.Close()
+
+	members := []*entity.WorkspaceGroupMember{}
+	for rows.Next() {
+		member := &entity.WorkspaceGroupMember{}
+		if err := rows.Scan(&member.GroupID, &member.UserID, &member.AddedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan group member: %w", err)
+		}
+		members = append(members, member)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating group members: %w", err)
+	}
+
+	return members, nil
+}
+
+func (gr *WorkspaceGroupRepositoryImpl) AddMembers(ctx context.Context, group *entity.WorkspaceGroup, userIDs []uuid.UUID) (int, error) {
+	tx, err := gr.db.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin add group members transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	now := time.Now().UTC()
+
+	added, err := collectUUIDs(ctx, tx, addWorkspaceGroupMembersQuery, group.ID, group.WorkspaceID, userIDs, now)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return 0, domain.ErrUserNotInWorkspace
+		}
+		return 0, fmt.Errorf("failed to add group members: %w", err)
+	}
+
+	if len(added) > 0 {
+		if _, err := tx.Exec(ctx, addGroupMembersToLinkedBoardsQuery, group.ID, added, now); err != nil {
+			return 0, fmt.Errorf("failed to add group members to linked boards: %w", err)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return len(added), nil
+}
+
+func (gr *WorkspaceGroupRepositoryImpl) RemoveMember(ctx context.Context, groupID, userID uuid.UUID) error {
+	tx, err := gr.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin remove group member transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, deleteWorkspaceGroupMemberQuery, groupID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to remove group member: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return domain.ErrGroupMemberNotFound
+	}
+
+	if _, err := tx.Exec(ctx, removeGroupMemberFromLinkedBoardsQuery, groupID, userID); err != nil {
+		return fmt.Errorf("failed to remove group member from linked boards: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (gr *WorkspaceGroupRepositoryImpl) AddToBoard(ctx context.Context, groupID, boardID, linkedBy uuid.UUID, sync bool) (int, error) {
+	tx, err := gr.db.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin add group to board transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	now := time.Now().UTC()
+
+	if sync {
+		if _, err := tx.Exec(ctx, linkBoardGroupQuery, boardID, groupID, linkedBy, now); err != nil {
+			return 0, fmt.Errorf("failed to link group to board: %w", err)
+		}
+	}
+
+	result, err := tx.Exec(ctx, addGroupMembersToBoardQuery, boardID, groupID, now, sync)
+	if err != nil {
+		return 0, fmt.Errorf("failed to add group members to board: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return int(result.RowsAffected()), nil
+}
+
+func (gr *WorkspaceGroupRepositoryImpl) UnlinkBoard(ctx context.Context, groupID, boardID uuid.UUID) error {
+	result, err := gr.db.Exec(ctx, unlinkBoardGroupQuery, boardID, groupID)
+	if err != nil {
+		return fmt.Errorf("failed to unlink group from board: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrBoardGroupLinkNotFound
+	}
+
+	return nil
+}
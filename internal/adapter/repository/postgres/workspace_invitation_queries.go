@@ -0,0 +1,38 @@
+package postgres
+
+const (
+	upsertWorkspaceInvitationQuery = `
+		INSERT INTO workspace_invitations (workspace_id, email, role, token_hash, invited_by, expires_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $7)
+		ON CONFLICT (workspace_id, email) WHERE status = 'PENDING'
+		DO UPDATE SET
+			role = EXCLUDED.role,
+			token_hash = EXCLUDED.token_hash,
+			invited_by = EXCLUDED.invited_by,
+			expires_at = EXCLUDED.expires_at,
+			updated_at = EXCLUDED.updated_at
+		RETURNING id, workspace_id, email, role, token_hash, status, invited_by, accepted_by, expires_at, accepted_at, created_at, updated_at
+	`
+	getWorkspaceInvitationByTokenHashQuery = `
+		SELECT id, workspace_id, email, role, token_hash, status, invited_by, accepted_by, expires_at, accepted_at, created_at, updated_at
+		FROM workspace_invitations
+		WHERE token_hash = $1
+	`
+	listPendingWorkspaceInvitationsByEmailQuery = `
+		SELECT id, workspace_id, email, role, token_hash, status, invited_by, accepted_by, expires_at, accepted_at, created_at, updated_at
+		FROM workspace_invitations
+		WHERE email = $1 AND status = 'PENDING' AND expires_at > $2
+		ORDER BY created_at
+	`
+	acceptWorkspaceInvitationQuery = `
+		UPDATE workspace_invitations
+		SET status = 'ACCEPTED', accepted_by = $2, accepted_at = $3, updated_at = $3
+		WHERE id = $1 AND status = 'PENDING'
+		RETURNING workspace_id, role
+	`
+	addInvitedWorkspaceMemberQuery = `
+		INSERT INTO workspace_members (workspace_id, user_id, role, joined_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (workspace_id, user_id) DO NOTHING
+	`
+)
@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/domain/entity"
+	"collabotask/internal/domain/repository"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type WorkspaceInvitationRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewWorkspaceInvitationRepository(db *pgxpool.Pool) repository.WorkspaceInvitationRepository {
+	return &WorkspaceInvitationRepositoryImpl{db: db}
+}
+
+func scanWorkspaceInvitation(row pgx.Row, invitation *entity.WorkspaceInvitation) error {
+	return row.Scan(
+		&invitation.ID,
+		&invitation.WorkspaceID,
+		&invitation.Email,
+		&invitation.Role,
+		&invitation.TokenHash,
+		&invitation.Status,
+		&invitation.InvitedBy,
+		&invitation.AcceptedBy,
+		&invitation.ExpiresAt,
+		&invitation.AcceptedAt,
+		&invitation.CreatedAt,
+		&invitation.UpdatedAt,
+	)
+}
+
+func (ir *WorkspaceInvitationRepositoryImpl) Upsert(ctx context.Context, invitation *entity.WorkspaceInvitation) error {
+	row := ir.db.QueryRow(
+		ctx,
+		upsertWorkspaceInvitationQuery,
+		invitation.WorkspaceID,
+		invitation.Email,
+		invitation.Role,
+		invitation.TokenHash,
+		invitation.InvitedBy,
+		invitation.ExpiresAt,
+		time.Now().UTC(),
+	)
+	if err := scanWorkspaceInvitation(row, invitation); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return domain.ErrConstraintViolation
+		}
+		return fmt.Errorf("failed to save workspace invitation: %w", err)
+	}
+
+	return nil
+}
+
+func (ir *WorkspaceInvitationRepositoryImpl) GetByTokenHash(ctx context.Context, tokenHash string) (*entity.WorkspaceInvitation, error) {
+	invitation := &entity.WorkspaceInvitation{}
+	if err := scanWorkspaceInvitation(ir.db.QueryRow(ctx, getWorkspaceInvitationByTokenHashQuery, tokenHash), invitation); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrInvalidInvitation
+		}
+		return nil, fmt.Errorf("failed to get workspace invitation: %w", err)
+	}
+
+	return invitation, nil
+}
+
+func (ir *WorkspaceInvitationRepositoryImpl) ListPendingByEmail(ctx context.Context, email string, now time.Time) ([]*entity.WorkspaceInvitation, error) {
+	rows, err := ir.db.Query(ctx, listPendingWorkspaceInvitationsByEmailQuery, email, now.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workspace invitations: %w", err)
+	}
+	defer rows.Close()
+
+	var invitations []*entity.WorkspaceInvitation
+	for rows.Next() {
+		invitation := &entity.WorkspaceInvitation{}
+		if err := scanWorkspaceInvitation(rows, invitation); err != nil {
+			return nil, fmt.Errorf("failed to scan workspace invitation: %w", err)
+		}
+		invitations = append(invitations, invitation)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list workspace invitations: %w", err)
+	}
+
+	return invitations, nil
+}
+
+func (ir *WorkspaceInvitationRepositoryImpl) Accept(ctx context.Context, invitationID, userID uuid.UUID, acceptedAt time.Time) error {
+	tx, err := ir.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	acceptedAt = acceptedAt.UTC()
+
+	var workspaceID uuid.UUID
+	var role entity.WorkspaceRole
+	err = tx.QueryRow(ctx, acceptWorkspaceInvitationQuery, invitationID, userID, acceptedAt).Scan(&workspaceID, &role)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrInvalidInvitation
+		}
+		return fmt.Errorf("failed to accept workspace invitation: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, addInvitedWorkspaceMemberQuery, workspaceID, userID, role, acceptedAt); err != nil {
+		return fmt.Errorf("failed to add member to workspace: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package postgres
+
+const (
+	createWorkspaceJoinLinkQuery = `
+		INSERT INTO workspace_join_links (workspace_id, code_prefix, code_hash, role, max_uses, expires_at, created_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, use_count, created_at
+	`
+	listActiveWorkspaceJoinLinksQuery = `
+		SELECT id, workspace_id, code_prefix, code_hash, role, max_uses, use_count, expires_at, created_by, revoked_at, created_at
+		FROM workspace_join_links
+		WHERE workspace_id = $1
+			AND revoked_at IS NULL
+			AND (expires_at IS NULL OR expires_at > $2)
+			AND (max_uses IS NULL OR use_count < max_uses)
+		ORDER BY created_at DESC
+	`
+	revokeWorkspaceJoinLinkQuery = `
+		UPDATE workspace_join_links
+		SET revoked_at = $3
+		WHERE id = $1 AND workspace_id = $2 AND revoked_at IS NULL
+	`
+	// The availability checks live in the UPDATE so two users racing for
+	// the last use cannot both get in.
+	consumeWorkspaceJoinLinkQuery = `
+		UPDATE workspace_join_links
+		SET use_count = use_count + 1
+		WHERE code_hash = $1
+			AND revoked_at IS NULL
+			AND (expires_at IS NULL OR expires_at > $2)
+			AND (max_uses IS NULL OR use_count < max_uses)
+		RETURNING workspace_id, role
+	`
+	createWorkspaceMemberIfAbsentQuery = `
+		INSERT INTO workspace_members (workspace_id, user_id, role, joined_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (workspace_id, user_id) DO NOTHING
+		RETURNING workspace_id, user_id, role, joined_at
+	`
+)
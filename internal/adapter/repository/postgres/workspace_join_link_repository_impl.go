@@ -0,0 +1,147 @@
+package postgres
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/domain/entity"
+	"collabotask/internal/domain/repository"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type WorkspaceJoinLinkRepositoryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewWorkspaceJoinLinkRepository(db *pgxpool.Pool) repository.WorkspaceJoinLinkRepository {
+	return &WorkspaceJoinLinkRepositoryImpl{db: db}
+}
+
+func (jr *WorkspaceJoinLinkRepositoryImpl) Create(ctx context.Context, link *entity.WorkspaceJoinLink) error {
+	var expiresAt *time.Time
+	if link.ExpiresAt != nil {
+		at := link.ExpiresAt.UTC()
+		expiresAt = &at
+	}
+
+	err := jr.db.QueryRow(
+		ctx,
+		createWorkspaceJoinLinkQuery,
+		link.WorkspaceID,
+		link.CodePrefix,
+		link.CodeHash,
+		link.Role,
+		link.MaxUses,
+		expiresAt,
+		link.CreatedBy,
+		time.Now().UTC(),
+	).Scan(&link.ID, &link.UseCount, &link.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create join link: %w", err)
+	}
+
+	return nil
+}
+
+func (jr *WorkspaceJoinLinkRepositoryImpl) ListActiveByWorkspace(ctx context.Context, workspaceID uuid.UUID, now time.Time) ([]*entity.WorkspaceJoinLink, error) {
+	rows, err := jr.db.Query(ctx, listActiveWorkspaceJoinLinksQuery, workspaceID, now.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list join links: %w", err)
+	}
+	defer rows.Close()
+
+	links := []*entity.WorkspaceJoinLink{}
+	for rows.Next() {
+		link, err := scanWorkspaceJoinLink(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan join link: %w", err)
+		}
+		links = append(links, link)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating join links: %w", err)
+	}
+
+	return links, nil
+}
+
+func (jr *WorkspaceJoinLinkRepositoryImpl) Revoke(ctx context.Context, id, workspaceID uuid.UUID) error {
+	result, err := jr.db.Exec(ctx, revokeWorkspaceJoinLinkQuery, id, workspaceID, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to revoke join link: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrJoinLinkNotFound
+	}
+
+	return nil
+}
+
+func (jr *WorkspaceJoinLinkRepositoryImpl) Redeem(ctx context.Context, codeHash string, userID uuid.UUID, now time.Time) (*entity.WorkspaceMember, error) {
+	tx, err := jr.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin redeem join link transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	now = now.UTC()
+
+	var workspaceID uuid.UUID
+	var role entity.WorkspaceRole
+	if err := tx.QueryRow(ctx, consumeWorkspaceJoinLinkQuery, codeHash, now).Scan(&workspaceID, &role); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrInvalidJoinLink
+		}
+		return nil, fmt.Errorf("failed to use join link: %w", err)
+	}
+
+	member := &entity.WorkspaceMember{}
+	err = tx.QueryRow(ctx, createWorkspaceMemberIfAbsentQuery, workspaceID, userID, role, now).Scan(
+		&member.WorkspaceID,
+		&member.UserID,
+		&member.Role,
+		&member.JoinedAt,
+	)
+	if err != nil {
+		// Rolling back returns the use to the link.
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrAlreadyMember
+		}
+		return nil, fmt.Errorf("failed to add member to workspace: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return member, nil
+}
+
+func scanWorkspaceJoinLink(row pgx.Row) (*entity.WorkspaceJoinLink, error) {
+	link := &entity.WorkspaceJoinLink{}
+	err := row.Scan(
+		&link.ID,
+		&link.WorkspaceID,
+		&link.CodePrefix,
+		&link.CodeHash,
+		&link.Role,
+		&link.MaxUses,
+		&link.UseCount,
+		&link.ExpiresAt,
+		&link.CreatedBy,
+		&link.RevokedAt,
+		&link.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return link, nil
+}
@@ -13,10 +13,85 @@ const (
 		SELECT workspace_id, user_id, role, joined_at FROM workspace_members
 		WHERE workspace_id = $1 AND user_id = $2
 	`
+	// listMembersPageQuery is completed by buildListMembersQuery with the
+	// sort column, the keyset comparison operator and the sort direction, all
+	// picked from fixed values. $5/$6 are the last row of the previous page
+	// and are ignored while $6 is NULL.
+	listMembersPageQuery = `
+		SELECT wm.workspace_id, wm.user_id, wm.role, wm.joined_at, u.email, u.name, u.avatar_url
+		FROM workspace_members wm
+		INNER JOIN users u ON u.id = wm.user_id
+		WHERE wm.workspace_id = $1
+			AND ($2 = '' OR lower(u.name) LIKE lower($2) || '%%' OR lower(u.email) LIKE lower($2) || '%%')
+			AND ($3 = '' OR wm.role = $3)
+			AND ($6::uuid IS NULL OR (%[1]s, wm.user_id) %[2]s ($5::text::%[3]s, $6::uuid))
+		ORDER BY %[1]s %[4]s, wm.user_id %[4]s
+		LIMIT $4
+	`
 	listMemberByWorkspaceQuery = `
 		SELECT workspace_id, user_id, role, joined_at FROM workspace_members
 		WHERE workspace_id = $1 ORDER BY joined_at ASC
 	`
+	// The owner always stays an admin, and demoting needs another admin to
+	// remain. Both are re-checked here so concurrent requests cannot race
+	// past the use case's checks.
+	updateWorkspaceMemberRoleQuery = `
+		UPDATE workspace_members wm
+		SET role = $3
+		WHERE wm.workspace_id = $1 AND wm.user_id = $2
+			AND (
+				$3 = 'ADMIN'
+				OR (
+					NOT EXISTS (SELECT 1 FROM workspaces w WHERE w.id = $1 AND w.owner_id = $2)
+					AND EXISTS (
+						SELECT 1 FROM workspace_members other
+						WHERE other.workspace_id = $1 AND other.user_id <> $2 AND other.role = 'ADMIN'
+					)
+				)
+			)
+		RETURNING wm.workspace_id, wm.user_id, wm.role, wm.joined_at
+	`
+	hasOwnedBoardInWorkspaceQuery = `
+		SELECT EXISTS(
+			SELECT 1 FROM boards b
+			WHERE b.workspace_id = $1
+				AND (
+					b.created_by = $2
+					OR EXISTS (
+						SELECT 1 FROM board_members bm
+						WHERE bm.board_id = b.id AND bm.user_id = $2 AND bm.role = 'BOARD_OWNER'
+					)
+				)
+		)
+	`
+	lockWorkspaceOwnerQuery = `
+		SELECT owner_id FROM workspaces WHERE id = $1 FOR UPDATE
+	`
+	getCreatedBoardsInWorkspaceQuery = `
+		SELECT id FROM boards WHERE workspace_id = $1 AND created_by = $2 FOR UPDATE
+	`
+	// Guests cannot own boards, so they are never picked as successor.
+	getWorkspaceBoardSuccessorQuery = `
+		SELECT bm.user_id
+		FROM board_members bm
+		JOIN boards b ON b.id = bm.board_id
+		JOIN workspace_members wm ON wm.workspace_id = b.workspace_id AND wm.user_id = bm.user_id
+		WHERE bm.board_id = $1 AND bm.user_id <> $2 AND wm.role <> 'GUEST'
+		ORDER BY CASE bm.role WHEN 'BOARD_OWNER' THEN 0 ELSE 1 END, bm.joined_at, bm.user_id
+		LIMIT 1
+	`
+	deleteBoardMembershipsInWorkspaceQuery = `
+		DELETE FROM board_members bm
+		USING boards b
+		WHERE bm.board_id = b.id AND b.workspace_id = $1 AND bm.user_id = $2
+	`
+	unassignCardsInWorkspaceQuery = `
+		UPDATE cards ca
+		SET assigned_to = NULL, updated_at = $3
+		FROM columns c
+		INNER JOIN boards b ON b.id = c.board_id
+		WHERE ca.column_id = c.id AND b.workspace_id = $1 AND ca.assigned_to = $2
+	`
 	isUserExistsOnWorkspaceQuery = `
 		SELECT EXISTS(
 			SELECT 1
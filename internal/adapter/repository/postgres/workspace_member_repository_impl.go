@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -51,6 +52,43 @@ func (wm *WorkspaceMemberRepositoryImpl) Create(ctx context.Context, workspaceMe
 	return nil
 }
 
+func (wm *WorkspaceMemberRepositoryImpl) CreateMany(ctx context.Context, members []*entity.WorkspaceMember) ([]*entity.WorkspaceMember, error) {
+	if len(members) == 0 {
+		return nil, nil
+	}
+
+	tx, err := wm.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	now := time.Now().UTC()
+	added := make([]*entity.WorkspaceMember, 0, len(members))
+	for _, member := range members {
+		err := tx.QueryRow(ctx, createWorkspaceMemberIfAbsentQuery, member.WorkspaceID, member.UserID, member.Role, now).Scan(
+			&member.WorkspaceID,
+			&member.UserID,
+			&member.Role,
+			&member.JoinedAt,
+		)
+		if err != nil {
+			// No row comes back when the user is already a member.
+			if errors.Is(err, pgx.ErrNoRows) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to add member to workspace: %w", err)
+		}
+		added = append(added, member)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return added, nil
+}
+
 func (wm *WorkspaceMemberRepositoryImpl) Delete(ctx context.Context, workspaceID, userID uuid.UUID) error {
 	result, err := wm.db.Exec(ctx, deleteWorkspaceMemberQuery, workspaceID, userID)
 	if err != nil {
@@ -64,6 +102,80 @@ func (wm *WorkspaceMemberRepositoryImpl) Delete(ctx context.Context, workspaceID
 	return nil
 }
 
+func (wm *WorkspaceMemberRepositoryImpl) Leave(ctx context.Context, workspaceID, userID uuid.UUID, unassignCards bool) (*entity.WorkspaceLeaveSummary, error) {
+	tx, err := wm.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin leave workspace transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Locking the workspace serializes the owner check with a concurrent
+	// ownership transfer.
+	var ownerID uuid.UUID
+	if err := tx.QueryRow(ctx, lockWorkspaceOwnerQuery, workspaceID).Scan(&ownerID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrWorkspaceNotFound
+		}
+		return nil, fmt.Errorf("failed to lock workspace: %w", err)
+	}
+	if ownerID == userID {
+		return nil, domain.ErrOwnerCannotLeave
+	}
+
+	result, err := tx.Exec(ctx, deleteWorkspaceMemberQuery, workspaceID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove user from workspace: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return nil, domain.ErrMemberNotFound
+	}
+
+	summary := &entity.WorkspaceLeaveSummary{}
+	now := time.Now().UTC()
+
+	boardIDs, err := collectUUIDs(ctx, tx, getCreatedBoardsInWorkspaceQuery, workspaceID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get created boards: %w", err)
+	}
+
+	// Boards nobody else can take fall back to the workspace owner.
+	for _, boardID := range boardIDs {
+		successorID := ownerID
+		err := tx.QueryRow(ctx, getWorkspaceBoardSuccessorQuery, boardID, userID).Scan(&successorID)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("failed to find board successor: %w", err)
+		}
+
+		if _, err := tx.Exec(ctx, transferBoardCreatorQuery, successorID, now, boardID); err != nil {
+			return nil, fmt.Errorf("failed to transfer board ownership: %w", err)
+		}
+		if _, err := tx.Exec(ctx, upsertBoardOwnerQuery, boardID, successorID, now); err != nil {
+			return nil, fmt.Errorf("failed to promote board successor: %w", err)
+		}
+		summary.TransferredBoards++
+	}
+
+	result, err = tx.Exec(ctx, deleteBoardMembershipsInWorkspaceQuery, workspaceID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove board memberships: %w", err)
+	}
+	summary.RemovedBoardMemberships = int(result.RowsAffected())
+
+	if unassignCards {
+		result, err = tx.Exec(ctx, unassignCardsInWorkspaceQuery, workspaceID, userID, now)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unassign cards: %w", err)
+		}
+		summary.UnassignedCards = int(result.RowsAffected())
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return summary, nil
+}
+
 func (wm *WorkspaceMemberRepositoryImpl) GetByWorkspaceAndUser(ctx context.Context, workspaceID, userID uuid.UUID) (*entity.WorkspaceMember, error) {
 	workspaceMember := &entity.WorkspaceMember{}
 	err := wm.db.QueryRow(
@@ -123,6 +235,110 @@ func (wm *WorkspaceMemberRepositoryImpl) GetMembersByWorkspace(ctx context.Conte
 	return workspaceMembers, nil
 }
 
+func (wm *WorkspaceMemberRepositoryImpl) ListMembers(ctx context.Context, workspaceID uuid.UUID, filter repository.WorkspaceMemberFilter) ([]*entity.WorkspaceMemberListItem, error) {
+	var afterUserID *uuid.UUID
+	if filter.AfterUserID != uuid.Nil {
+		afterUserID = &filter.AfterUserID
+	}
+
+	rows, err := wm.db.Query(
+		ctx,
+		buildListMembersQuery(filter.Sort, filter.Descending),
+		workspaceID,
+		escapeLikePattern(filter.Search),
+		string(filter.Role),
+		filter.Limit,
+		filter.AfterValue,
+		afterUserID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list members in workspace: %w", err)
+	}
+	defer rows.Close()
+
+	members := []*entity.WorkspaceMemberListItem{}
+	for rows.Next() {
+		member := &entity.WorkspaceMemberListItem{}
+		err := rows.Scan(
+			&member.WorkspaceID,
+			&member.UserID,
+			&member.Role,
+			&member.JoinedAt,
+			&member.Email,
+			&member.Name,
+			&member.AvatarURL,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan member in workspace: %w", err)
+		}
+
+		members = append(members, member)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating members in workspace: %w", err)
+	}
+
+	return members, nil
+}
+
+// buildListMembersQuery fills listMembersPageQuery for the sort; unknown
+// sorts fall back to name.
+func buildListMembersQuery(sort repository.WorkspaceMemberSort, descending bool) string {
+	column, columnType := "u.name", "text"
+	switch sort {
+	case repository.WorkspaceMemberSortEmail:
+		column = "u.email"
+	case repository.WorkspaceMemberSortJoinedAt:
+		column, columnType = "wm.joined_at", "timestamp"
+	}
+
+	operator, direction := ">", "ASC"
+	if descending {
+		operator, direction = "<", "DESC"
+	}
+
+	return fmt.Sprintf(listMembersPageQuery, column, operator, columnType, direction)
+}
+
+func (wm *WorkspaceMemberRepositoryImpl) UpdateRole(ctx context.Context, workspaceID, userID uuid.UUID, role entity.WorkspaceRole) (*entity.WorkspaceMember, error) {
+	tx, err := wm.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin update member role transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if role == entity.WorkspaceRoleGuest {
+		var ownsBoard bool
+		if err := tx.QueryRow(ctx, hasOwnedBoardInWorkspaceQuery, workspaceID, userID).Scan(&ownsBoard); err != nil {
+			return nil, fmt.Errorf("failed to check board ownership: %w", err)
+		}
+		if ownsBoard {
+			return nil, domain.ErrGuestCannotOwnBoards
+		}
+	}
+
+	workspaceMember := &entity.WorkspaceMember{}
+	err = tx.QueryRow(ctx, updateWorkspaceMemberRoleQuery, workspaceID, userID, role).Scan(
+		&workspaceMember.WorkspaceID,
+		&workspaceMember.UserID,
+		&workspaceMember.Role,
+		&workspaceMember.JoinedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrLastWorkspaceAdmin
+		}
+		return nil, fmt.Errorf("failed to update member role: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return workspaceMember, nil
+}
+
 func (wm *WorkspaceMemberRepositoryImpl) IsUserExists(ctx context.Context, workspaceID, userID uuid.UUID) (bool, error) {
 	var exists bool
 	err := wm.db.QueryRow(
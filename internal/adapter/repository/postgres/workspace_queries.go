@@ -10,11 +10,37 @@ const (
 		UPDATE workspaces
 		SET
 			name = COALESCE($1, name),
-			description = COALESCE($2, description),
+			description = $2,
 			updated_at = $3
 		WHERE id = $4
 		RETURNING id, name, description, owner_id, created_at, updated_at
 	`
+	transferWorkspaceOwnershipQuery = `
+		UPDATE workspaces
+		SET owner_id = $3, updated_at = $4
+		WHERE id = $1 AND owner_id = $2
+		RETURNING id, name, description, owner_id, created_at, updated_at
+	`
+	promoteWorkspaceOwnerQuery = `
+		UPDATE workspace_members
+		SET role = 'ADMIN'
+		WHERE workspace_id = $1 AND user_id = $2
+	`
+	lockWorkspaceQuery = `
+		SELECT id FROM workspaces WHERE id = $1 FOR UPDATE
+	`
+	countWorkspaceContentsQuery = `
+		SELECT
+			(SELECT COUNT(*) FROM boards b WHERE b.workspace_id = $1) AS board_count,
+			(SELECT COUNT(*) FROM columns c
+				INNER JOIN boards b ON b.id = c.board_id
+				WHERE b.workspace_id = $1) AS column_count,
+			(SELECT COUNT(*) FROM cards ca
+				INNER JOIN columns c ON c.id = ca.column_id
+				INNER JOIN boards b ON b.id = c.board_id
+				WHERE b.workspace_id = $1) AS card_count,
+			(SELECT COUNT(*) FROM workspace_members wm WHERE wm.workspace_id = $1) AS member_count
+	`
 	deleteWorkspaceQuery = `
 		DELETE FROM workspaces WHERE id = $1
 	`
@@ -35,4 +61,19 @@ const (
 		GROUP BY w.id, w.name, w.description, w.owner_id, w.created_at, w.updated_at, wm.role
 		ORDER BY w.created_at DESC
 	`
+	listWorkspacesQuery = `
+		SELECT
+			w.id, w.name, w.description, w.owner_id, w.created_at, w.updated_at,
+			(SELECT COUNT(*) FROM workspace_members wm WHERE wm.workspace_id = w.id) AS member_count,
+			(SELECT COUNT(*) FROM boards b WHERE b.workspace_id = w.id) AS board_count
+		FROM workspaces w
+		WHERE $1 = '' OR w.name ILIKE '%' || $1 || '%'
+		ORDER BY w.created_at DESC
+		LIMIT $2 OFFSET $3
+	`
+	countWorkspacesQuery = `
+		SELECT COUNT(*)
+		FROM workspaces w
+		WHERE $1 = '' OR w.name ILIKE '%' || $1 || '%'
+	`
 )
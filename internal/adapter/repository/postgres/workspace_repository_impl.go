@@ -114,18 +114,57 @@ func (w *WorkspaceRepositoryImpl) CreateWithOwner(ctx context.Context, workspace
 	return nil
 }
 
+func (w *WorkspaceRepositoryImpl) TransferOwnership(ctx context.Context, workspaceID, fromUserID, toUserID uuid.UUID) (*entity.Workspace, error) {
+	tx, err := w.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transfer ownership transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	workspace := &entity.Workspace{}
+	err = tx.QueryRow(ctx, transferWorkspaceOwnershipQuery, workspaceID, fromUserID, toUserID, time.Now().UTC()).Scan(
+		&workspace.ID,
+		&workspace.Name,
+		&workspace.Description,
+		&workspace.OwnerID,
+		&workspace.CreatedAt,
+		&workspace.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrNotWorkspaceOwner
+		}
+		return nil, fmt.Errorf("failed to transfer workspace ownership: %w", err)
+	}
+
+	result, err := tx.Exec(ctx, promoteWorkspaceOwnerQuery, workspaceID, toUserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to promote new owner: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return nil, domain.ErrMemberNotFound
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return workspace, nil
+}
+
 func (w *WorkspaceRepositoryImpl) Update(ctx context.Context, workspace *entity.Workspace) error {
 	var name *string
 	if workspace.Name != "" {
 		name = &workspace.Name
 	}
 
+	// Description is written as-is so callers can clear it with nil.
 	var description *string
 	if workspace.Description != nil && *workspace.Description != "" {
 		description = workspace.Description
 	}
 
-	updatedAt := time.Now()
+	updatedAt := time.Now().UTC()
 
 	err := w.db.QueryRow(
 		ctx,
@@ -154,17 +193,48 @@ func (w *WorkspaceRepositoryImpl) Update(ctx context.Context, workspace *entity.
 	return nil
 }
 
-func (w *WorkspaceRepositoryImpl) Delete(ctx context.Context, workspaceID uuid.UUID) error {
-	result, err := w.db.Exec(ctx, deleteWorkspaceQuery, workspaceID)
+func (w *WorkspaceRepositoryImpl) Delete(ctx context.Context, workspaceID uuid.UUID) (*entity.WorkspaceDeletionSummary, error) {
+	tx, err := w.db.Begin(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to delete workspace: %w", err)
+		return nil, fmt.Errorf("failed to begin delete workspace transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Locking the row keeps boards from being added between the count and
+	// the cascade.
+	var lockedID uuid.UUID
+	if err := tx.QueryRow(ctx, lockWorkspaceQuery, workspaceID).Scan(&lockedID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrWorkspaceNotFound
+		}
+		return nil, fmt.Errorf("failed to lock workspace: %w", err)
 	}
 
+	var boards, columns, cards, members int64
+	if err := tx.QueryRow(ctx, countWorkspaceContentsQuery, workspaceID).Scan(&boards, &columns, &cards, &members); err != nil {
+		return nil, fmt.Errorf("failed to count workspace contents: %w", err)
+	}
+
+	// Boards, columns, cards, memberships and invitations go with the
+	// workspace through ON DELETE CASCADE.
+	result, err := tx.Exec(ctx, deleteWorkspaceQuery, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete workspace: %w", err)
+	}
 	if result.RowsAffected() == 0 {
-		return domain.ErrWorkspaceNotFound
+		return nil, domain.ErrWorkspaceNotFound
 	}
 
-	return nil
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return &entity.WorkspaceDeletionSummary{
+		Boards:  int(boards),
+		Columns: int(columns),
+		Cards:   int(cards),
+		Members: int(members),
+	}, nil
 }
 
 func (w *WorkspaceRepositoryImpl) GetByID(ctx context.Context, workspaceID uuid.UUID) (*entity.Workspace, error) {
@@ -235,3 +305,53 @@ func (w *WorkspaceRepositoryImpl) GetUserWorkspaces(ctx context.Context, userID
 
 	return workspaces, nil
 }
+
+func (w *WorkspaceRepositoryImpl) List(ctx context.Context, search string, limit, offset int) ([]*entity.WorkspaceSummary, error) {
+	rows, err := w.db.Query(ctx, listWorkspacesQuery, escapeLikePattern(search), limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workspaces: %w", err)
+	}
+	defer rows.Close()
+
+	workspaces := make([]*entity.WorkspaceSummary, 0, limit)
+	for rows.Next() {
+		var description *string
+		var memberCount, boardCount int64
+		workspace := &entity.WorkspaceSummary{}
+
+		err := rows.Scan(
+			&workspace.ID,
+			&workspace.Name,
+			&description,
+			&workspace.OwnerID,
+			&workspace.CreatedAt,
+			&workspace.UpdatedAt,
+			&memberCount,
+			&boardCount,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan workspace: %w", err)
+		}
+
+		workspace.Description = description
+		workspace.MemberCount = uint(memberCount)
+		workspace.BoardCount = uint(boardCount)
+
+		workspaces = append(workspaces, workspace)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating workspaces: %w", err)
+	}
+
+	return workspaces, nil
+}
+
+func (w *WorkspaceRepositoryImpl) Count(ctx context.Context, search string) (int, error) {
+	var count int
+	if err := w.db.QueryRow(ctx, countWorkspacesQuery, escapeLikePattern(search)).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count workspaces: %w", err)
+	}
+
+	return count, nil
+}
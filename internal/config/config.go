@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"net"
 	"os"
 	"strconv"
 	"strings"
@@ -17,6 +18,9 @@ type Config struct {
 	Log      LogConfig
 	CORS     CORSConfig
 	Auth     AuthConfig
+	Mail     MailConfig
+	OIDC     OIDCConfig
+	Storage  StorageConfig
 }
 
 type AppConfig struct {
@@ -30,6 +34,9 @@ type ServerConfig struct {
 	Port    string
 	Host    string
 	Timeout time.Duration
+	// TrustedProxies lists the IPs or CIDRs allowed to set X-Forwarded-For.
+	// Empty means the client IP is always the connecting address.
+	TrustedProxies []string
 }
 
 type DatabaseConfig struct {
@@ -59,9 +66,68 @@ type CORSConfig struct {
 }
 
 type AuthConfig struct {
-	JWTSecret     string
-	JWTExpiration time.Duration
-	BcryptCost    int
+	JWTSecret                     string
+	JWTAlgorithm                  string
+	JWTPrivateKeyFile             string
+	JWTPublicKeyFiles             []string
+	JWTExpiration                 time.Duration
+	RefreshTokenExpiration        time.Duration
+	RevocationCacheTTL            time.Duration
+	BcryptCost                    int
+	PasswordResetURL              string
+	PasswordResetTTL              time.Duration
+	EmailVerificationURL          string
+	EmailVerificationTTL          time.Duration
+	RequireVerifiedEmailForLogin  bool
+	RequireVerifiedEmailForInvite bool
+	WorkspaceInvitationURL        string
+	WorkspaceInvitationTTL        time.Duration
+	LoginMaxAccountFailures       int
+	LoginMaxIPFailures            int
+	LoginBackoffBase              time.Duration
+	LoginLockoutDuration          time.Duration
+	LoginFailureWindow            time.Duration
+	MFAIssuer                     string
+	MFAChallengeTTL               time.Duration
+	MFAEncryptionKey              string
+}
+
+type MailConfig struct {
+	Driver       string
+	From         string
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUsername string
+	SMTPPassword string
+	FileDir      string
+}
+
+// OIDCConfig enables single sign-on through an OpenID Connect provider when
+// IssuerURL is set.
+type OIDCConfig struct {
+	IssuerURL         string
+	ClientID          string
+	ClientSecret      string
+	RedirectURL       string
+	Scopes            []string
+	StateTTL          time.Duration
+	AllowProvisioning bool
+}
+
+// StorageConfig selects where uploaded files such as avatars are kept.
+type StorageConfig struct {
+	Driver            string
+	LocalDir          string
+	S3Endpoint        string
+	S3Region          string
+	S3Bucket          string
+	S3AccessKeyID     string
+	S3SecretAccessKey string
+	S3ForcePathStyle  bool
+	SignedURLTTL      time.Duration
+	AvatarMaxBytes    int64
+	AvatarMaxPixels   int
+	AvatarBaseURL     string
 }
 
 func getEnv(key, defaultValue string) string {
@@ -120,9 +186,10 @@ func Load() (*Config, error) {
 			Debug:       getEnvBool("APP_DEBUG", false),
 		},
 		Server: ServerConfig{
-			Port:    getEnv("SERVER_PORT", "8080"),
-			Host:    getEnv("SERVER_HOST", "localhost"),
-			Timeout: getEnvDuration("SERVER_TIMEOUT", 30*time.Second),
+			Port:           getEnv("SERVER_PORT", "8080"),
+			Host:           getEnv("SERVER_HOST", "localhost"),
+			Timeout:        getEnvDuration("SERVER_TIMEOUT", 30*time.Second),
+			TrustedProxies: getEnvStringSlice("SERVER_TRUSTED_PROXIES", nil),
 		},
 		Database: DatabaseConfig{
 			Host:            getEnv("DB_HOST", "localhost"),
@@ -148,9 +215,62 @@ func Load() (*Config, error) {
 			MaxAge:           getEnvInt("CORS_MAX_AGE", 3600),
 		},
 		Auth: AuthConfig{
-			JWTSecret:     getEnv("AUTH_JWT_SECRET", ""),
-			JWTExpiration: getEnvDuration("AUTH_JWT_EXPIRATION", 24*time.Hour),
-			BcryptCost:    getEnvInt("AUTH_BCRYPT_COST", 12),
+			JWTSecret:                     getEnv("AUTH_JWT_SECRET", ""),
+			JWTAlgorithm:                  getEnv("AUTH_JWT_ALGORITHM", "HS256"),
+			JWTPrivateKeyFile:             getEnv("AUTH_JWT_PRIVATE_KEY_FILE", ""),
+			JWTPublicKeyFiles:             getEnvStringSlice("AUTH_JWT_PUBLIC_KEY_FILES", nil),
+			JWTExpiration:                 getEnvDuration("AUTH_JWT_EXPIRATION", 15*time.Minute),
+			RefreshTokenExpiration:        getEnvDuration("AUTH_REFRESH_TOKEN_EXPIRATION", 30*24*time.Hour),
+			RevocationCacheTTL:            getEnvDuration("AUTH_REVOCATION_CACHE_TTL", 30*time.Second),
+			BcryptCost:                    getEnvInt("AUTH_BCRYPT_COST", 12),
+			PasswordResetURL:              getEnv("AUTH_PASSWORD_RESET_URL", "http://localhost:3000/reset-password"),
+			PasswordResetTTL:              getEnvDuration("AUTH_PASSWORD_RESET_TTL", time.Hour),
+			EmailVerificationURL:          getEnv("AUTH_EMAIL_VERIFICATION_URL", "http://localhost:8080/api/v1/auth/verify"),
+			EmailVerificationTTL:          getEnvDuration("AUTH_EMAIL_VERIFICATION_TTL", 24*time.Hour),
+			RequireVerifiedEmailForLogin:  getEnvBool("AUTH_REQUIRE_VERIFIED_EMAIL_FOR_LOGIN", false),
+			RequireVerifiedEmailForInvite: getEnvBool("AUTH_REQUIRE_VERIFIED_EMAIL_FOR_INVITE", false),
+			WorkspaceInvitationURL:        getEnv("AUTH_WORKSPACE_INVITATION_URL", "http://localhost:3000/invitations"),
+			WorkspaceInvitationTTL:        getEnvDuration("AUTH_WORKSPACE_INVITATION_TTL", 7*24*time.Hour),
+			LoginMaxAccountFailures:       getEnvInt("AUTH_LOGIN_MAX_ACCOUNT_FAILURES", 5),
+			LoginMaxIPFailures:            getEnvInt("AUTH_LOGIN_MAX_IP_FAILURES", 20),
+			LoginBackoffBase:              getEnvDuration("AUTH_LOGIN_BACKOFF_BASE", time.Second),
+			LoginLockoutDuration:          getEnvDuration("AUTH_LOGIN_LOCKOUT_DURATION", 15*time.Minute),
+			LoginFailureWindow:            getEnvDuration("AUTH_LOGIN_FAILURE_WINDOW", 15*time.Minute),
+			MFAIssuer:                     getEnv("AUTH_MFA_ISSUER", "Collabotask"),
+			MFAChallengeTTL:               getEnvDuration("AUTH_MFA_CHALLENGE_TTL", 5*time.Minute),
+			MFAEncryptionKey:              getEnv("AUTH_MFA_ENCRYPTION_KEY", ""),
+		},
+		Mail: MailConfig{
+			Driver:       getEnv("MAIL_DRIVER", "log"),
+			From:         getEnv("MAIL_FROM", "no-reply@collabotask.local"),
+			SMTPHost:     getEnv("MAIL_SMTP_HOST", ""),
+			SMTPPort:     getEnv("MAIL_SMTP_PORT", "587"),
+			SMTPUsername: getEnv("MAIL_SMTP_USERNAME", ""),
+			SMTPPassword: getEnv("MAIL_SMTP_PASSWORD", ""),
+			FileDir:      getEnv("MAIL_FILE_DIR", "tmp/mail"),
+		},
+		OIDC: OIDCConfig{
+			IssuerURL:         getEnv("OIDC_ISSUER_URL", ""),
+			ClientID:          getEnv("OIDC_CLIENT_ID", ""),
+			ClientSecret:      getEnv("OIDC_CLIENT_SECRET", ""),
+			RedirectURL:       getEnv("OIDC_REDIRECT_URL", "http://localhost:8080/api/v1/auth/oidc/callback"),
+			Scopes:            getEnvStringSlice("OIDC_SCOPES", []string{"openid", "email", "profile"}),
+			StateTTL:          getEnvDuration("OIDC_STATE_TTL", 10*time.Minute),
+			AllowProvisioning: getEnvBool("OIDC_ALLOW_PROVISIONING", true),
+		},
+		Storage: StorageConfig{
+			Driver:            getEnv("STORAGE_DRIVER", "local"),
+			LocalDir:          getEnv("STORAGE_LOCAL_DIR", "tmp/storage"),
+			S3Endpoint:        getEnv("STORAGE_S3_ENDPOINT", ""),
+			S3Region:          getEnv("STORAGE_S3_REGION", "us-east-1"),
+			S3Bucket:          getEnv("STORAGE_S3_BUCKET", ""),
+			S3AccessKeyID:     getEnv("STORAGE_S3_ACCESS_KEY_ID", ""),
+			S3SecretAccessKey: getEnv("STORAGE_S3_SECRET_ACCESS_KEY", ""),
+			S3ForcePathStyle:  getEnvBool("STORAGE_S3_FORCE_PATH_STYLE", false),
+			SignedURLTTL:      getEnvDuration("STORAGE_SIGNED_URL_TTL", 15*time.Minute),
+			AvatarMaxBytes:    int64(getEnvInt("STORAGE_AVATAR_MAX_BYTES", 5<<20)),
+			AvatarMaxPixels:   getEnvInt("STORAGE_AVATAR_MAX_PIXELS", 25_000_000),
+			AvatarBaseURL:     getEnv("STORAGE_AVATAR_BASE_URL", "http://localhost:8080/api/v1/users"),
 		},
 	}
 
@@ -169,8 +289,68 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("DB_USER is required")
 	}
 
-	if c.Auth.JWTSecret == "" {
-		return fmt.Errorf("AUTH_JWT_SECRET is required")
+	for _, proxy := range c.Server.TrustedProxies {
+		if net.ParseIP(proxy) != nil {
+			continue
+		}
+		if _, _, err := net.ParseCIDR(proxy); err != nil {
+			return fmt.Errorf("SERVER_TRUSTED_PROXIES must be a comma-separated list of IPs or CIDRs, got %q", proxy)
+		}
+	}
+
+	switch c.Auth.JWTAlgorithm {
+	case "HS256":
+		if c.Auth.JWTSecret == "" {
+			return fmt.Errorf("AUTH_JWT_SECRET is required")
+		}
+	case "RS256", "EdDSA":
+		if c.Auth.JWTPrivateKeyFile == "" {
+			return fmt.Errorf("AUTH_JWT_PRIVATE_KEY_FILE is required when AUTH_JWT_ALGORITHM is %s", c.Auth.JWTAlgorithm)
+		}
+	default:
+		return fmt.Errorf("AUTH_JWT_ALGORITHM must be one of: HS256, RS256, EdDSA")
+	}
+	if c.Auth.MFAEncryptionKey == "" {
+		return fmt.Errorf("AUTH_MFA_ENCRYPTION_KEY is required")
+	}
+	if c.Auth.LoginBackoffBase <= 0 || c.Auth.LoginLockoutDuration < c.Auth.LoginBackoffBase {
+		return fmt.Errorf("AUTH_LOGIN_LOCKOUT_DURATION must be at least AUTH_LOGIN_BACKOFF_BASE")
+	}
+
+	validMailDrivers := map[string]bool{
+		"smtp": true,
+		"file": true,
+		"log":  true,
+	}
+
+	if !validMailDrivers[c.Mail.Driver] {
+		return fmt.Errorf("MAIL_DRIVER must be one of: smtp, file, log")
+	}
+	if c.Mail.Driver == "smtp" && c.Mail.SMTPHost == "" {
+		return fmt.Errorf("MAIL_SMTP_HOST is required when MAIL_DRIVER is smtp")
+	}
+
+	if c.OIDC.IssuerURL != "" && c.OIDC.ClientID == "" {
+		return fmt.Errorf("OIDC_CLIENT_ID is required when OIDC_ISSUER_URL is set")
+	}
+
+	switch c.Storage.Driver {
+	case "local":
+		if c.Storage.LocalDir == "" {
+			return fmt.Errorf("STORAGE_LOCAL_DIR is required when STORAGE_DRIVER is local")
+		}
+	case "s3":
+		if c.Storage.S3Endpoint == "" || c.Storage.S3Bucket == "" {
+			return fmt.Errorf("STORAGE_S3_ENDPOINT and STORAGE_S3_BUCKET are required when STORAGE_DRIVER is s3")
+		}
+		if c.Storage.S3AccessKeyID == "" || c.Storage.S3SecretAccessKey == "" {
+			return fmt.Errorf("STORAGE_S3_ACCESS_KEY_ID and STORAGE_S3_SECRET_ACCESS_KEY are required when STORAGE_DRIVER is s3")
+		}
+	default:
+		return fmt.Errorf("STORAGE_DRIVER must be one of: local, s3")
+	}
+	if c.Storage.AvatarMaxBytes <= 0 || c.Storage.AvatarMaxPixels <= 0 {
+		return fmt.Errorf("STORAGE_AVATAR_MAX_BYTES and STORAGE_AVATAR_MAX_PIXELS must be positive")
 	}
 
 	validEnvs := map[string]bool{
@@ -182,6 +362,11 @@ func (c *Config) Validate() error {
 	if !validEnvs[c.App.Environment] {
 		return fmt.Errorf("APP_ENV must be one of: development, staging, production")
 	}
+	// The log driver prints password reset, verification and invitation
+	// links in full.
+	if c.App.Environment == "production" && c.Mail.Driver == "log" {
+		return fmt.Errorf("MAIL_DRIVER must not be log when APP_ENV is production")
+	}
 
 	return nil
 }
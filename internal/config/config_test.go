@@ -120,9 +120,15 @@ func TestConfigValidation(t *testing.T) {
 	originalDBName := os.Getenv("DB_NAME")
 	originalDBUser := os.Getenv("DB_USER")
 	originalAppEnv := os.Getenv("APP_ENV")
+	originalMailDriver := os.Getenv("MAIL_DRIVER")
 
 	// Clean up
 	defer func() {
+		if originalMailDriver != "" {
+			os.Setenv("MAIL_DRIVER", originalMailDriver)
+		} else {
+			os.Unsetenv("MAIL_DRIVER")
+		}
 		if originalDBName != "" {
 			os.Setenv("DB_NAME", originalDBName)
 		} else {
@@ -176,6 +182,24 @@ func TestConfigValidation(t *testing.T) {
 	if err != nil && err.Error() != "config validation failed: APP_ENV must be one of: development, staging, production" {
 		t.Errorf("Expected 'APP_ENV must be one of...' error, got: %v", err)
 	}
+
+	// Test log mail driver in production
+	os.Setenv("APP_ENV", "production")
+	os.Unsetenv("MAIL_DRIVER")
+
+	_, err = Load()
+	if err == nil {
+		t.Error("Expected error when MAIL_DRIVER is log in production, got nil")
+	}
+	if err != nil && err.Error() != "config validation failed: MAIL_DRIVER must not be log when APP_ENV is production" {
+		t.Errorf("Expected 'MAIL_DRIVER must not be log...' error, got: %v", err)
+	}
+
+	os.Setenv("MAIL_DRIVER", "file")
+
+	if _, err = Load(); err != nil {
+		t.Errorf("Expected file mail driver to be accepted in production, got: %v", err)
+	}
 }
 
 func TestConfigDurationParsing(t *testing.T) {
@@ -0,0 +1,10 @@
+package entity
+
+// AccountDeletionSummary reports what happened to the resources a user owned
+// when their account was deleted.
+type AccountDeletionSummary struct {
+	TransferredWorkspaces int
+	DeletedWorkspaces     int
+	TransferredBoards     int
+	ReassignedCards       int
+}
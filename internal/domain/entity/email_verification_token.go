@@ -0,0 +1,32 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EmailVerificationToken struct {
+	ID        uuid.UUID  `json:"id" db:"id"`
+	UserID    uuid.UUID  `json:"user_id" db:"user_id"`
+	TokenHash string     `json:"-" db:"token_hash"`
+	ExpiresAt time.Time  `json:"expires_at" db:"expires_at"`
+	UsedAt    *time.Time `json:"used_at" db:"used_at"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+}
+
+func (EmailVerificationToken) TableName() string {
+	return "email_verification_tokens"
+}
+
+func (vt *EmailVerificationToken) IsEmpty() bool {
+	return vt.ID == uuid.Nil
+}
+
+func (vt *EmailVerificationToken) IsExpired(now time.Time) bool {
+	return !now.Before(vt.ExpiresAt)
+}
+
+func (vt *EmailVerificationToken) IsUsed() bool {
+	return vt.UsedAt != nil
+}
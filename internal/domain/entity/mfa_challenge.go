@@ -0,0 +1,33 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MFAChallenge struct {
+	ID        uuid.UUID  `json:"id" db:"id"`
+	UserID    uuid.UUID  `json:"user_id" db:"user_id"`
+	TokenHash string     `json:"-" db:"token_hash"`
+	ExpiresAt time.Time  `json:"expires_at" db:"expires_at"`
+	Attempts  int        `json:"attempts" db:"attempts"`
+	UsedAt    *time.Time `json:"used_at" db:"used_at"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+}
+
+func (MFAChallenge) TableName() string {
+	return "mfa_challenges"
+}
+
+func (c *MFAChallenge) IsEmpty() bool {
+	return c.ID == uuid.Nil
+}
+
+func (c *MFAChallenge) IsExpired(now time.Time) bool {
+	return !now.Before(c.ExpiresAt)
+}
+
+func (c *MFAChallenge) IsUsed() bool {
+	return c.UsedAt != nil
+}
@@ -0,0 +1,26 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OIDCLoginState keeps what is needed to finish an authorization-code login
+// between the redirect to the provider and its callback.
+type OIDCLoginState struct {
+	ID           uuid.UUID `json:"id" db:"id"`
+	StateHash    string    `json:"-" db:"state_hash"`
+	Nonce        string    `json:"-" db:"nonce"`
+	CodeVerifier string    `json:"-" db:"code_verifier"`
+	ExpiresAt    time.Time `json:"expires_at" db:"expires_at"`
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+}
+
+func (OIDCLoginState) TableName() string {
+	return "oidc_login_states"
+}
+
+func (s *OIDCLoginState) IsExpired(now time.Time) bool {
+	return !now.Before(s.ExpiresAt)
+}
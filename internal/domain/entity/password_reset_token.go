@@ -0,0 +1,32 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PasswordResetToken struct {
+	ID        uuid.UUID  `json:"id" db:"id"`
+	UserID    uuid.UUID  `json:"user_id" db:"user_id"`
+	TokenHash string     `json:"-" db:"token_hash"`
+	ExpiresAt time.Time  `json:"expires_at" db:"expires_at"`
+	UsedAt    *time.Time `json:"used_at" db:"used_at"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+}
+
+func (PasswordResetToken) TableName() string {
+	return "password_reset_tokens"
+}
+
+func (pt *PasswordResetToken) IsEmpty() bool {
+	return pt.ID == uuid.Nil
+}
+
+func (pt *PasswordResetToken) IsExpired(now time.Time) bool {
+	return !now.Before(pt.ExpiresAt)
+}
+
+func (pt *PasswordResetToken) IsUsed() bool {
+	return pt.UsedAt != nil
+}
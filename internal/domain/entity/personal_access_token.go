@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AccessTokenScope string
+
+const (
+	AccessTokenScopeReadOnly  AccessTokenScope = "read-only"
+	AccessTokenScopeReadWrite AccessTokenScope = "read-write"
+)
+
+type PersonalAccessToken struct {
+	ID          uuid.UUID        `json:"id" db:"id"`
+	UserID      uuid.UUID        `json:"user_id" db:"user_id"`
+	Name        string           `json:"name" db:"name"`
+	TokenPrefix string           `json:"token_prefix" db:"token_prefix"`
+	TokenHash   string           `json:"-" db:"token_hash"`
+	Scope       AccessTokenScope `json:"scope" db:"scope"`
+	ExpiresAt   *time.Time       `json:"expires_at" db:"expires_at"`
+	LastUsedAt  *time.Time       `json:"last_used_at" db:"last_used_at"`
+	RevokedAt   *time.Time       `json:"revoked_at" db:"revoked_at"`
+	CreatedAt   time.Time        `json:"created_at" db:"created_at"`
+}
+
+func (PersonalAccessToken) TableName() string {
+	return "personal_access_tokens"
+}
+
+func (t *PersonalAccessToken) IsEmpty() bool {
+	return t.ID == uuid.Nil
+}
+
+func (t *PersonalAccessToken) IsExpired(now time.Time) bool {
+	return t.ExpiresAt != nil && !now.Before(*t.ExpiresAt)
+}
+
+func (t *PersonalAccessToken) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
+
+func (t *PersonalAccessToken) IsReadOnly() bool {
+	return t.Scope == AccessTokenScopeReadOnly
+}
@@ -0,0 +1,38 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RefreshToken struct {
+	ID        uuid.UUID  `json:"id" db:"id"`
+	UserID    uuid.UUID  `json:"user_id" db:"user_id"`
+	FamilyID  uuid.UUID  `json:"family_id" db:"family_id"`
+	TokenHash string     `json:"-" db:"token_hash"`
+	ExpiresAt time.Time  `json:"expires_at" db:"expires_at"`
+	UsedAt    *time.Time `json:"used_at" db:"used_at"`
+	RevokedAt *time.Time `json:"revoked_at" db:"revoked_at"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+}
+
+func (RefreshToken) TableName() string {
+	return "refresh_tokens"
+}
+
+func (rt *RefreshToken) IsEmpty() bool {
+	return rt.ID == uuid.Nil
+}
+
+func (rt *RefreshToken) IsExpired(now time.Time) bool {
+	return !now.Before(rt.ExpiresAt)
+}
+
+func (rt *RefreshToken) IsUsed() bool {
+	return rt.UsedAt != nil
+}
+
+func (rt *RefreshToken) IsRevoked() bool {
+	return rt.RevokedAt != nil
+}
@@ -0,0 +1,32 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Session is one signed-in device. Its ID doubles as the family ID of the
+// refresh tokens issued to that device and is carried in the "sid" claim of
+// its access tokens.
+type Session struct {
+	ID         uuid.UUID  `json:"id" db:"id"`
+	UserID     uuid.UUID  `json:"user_id" db:"user_id"`
+	UserAgent  string     `json:"user_agent" db:"user_agent"`
+	IPAddress  string     `json:"ip_address" db:"ip_address"`
+	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+	LastSeenAt time.Time  `json:"last_seen_at" db:"last_seen_at"`
+	RevokedAt  *time.Time `json:"revoked_at" db:"revoked_at"`
+}
+
+func (Session) TableName() string {
+	return "sessions"
+}
+
+func (s *Session) IsEmpty() bool {
+	return s.ID == uuid.Nil
+}
+
+func (s *Session) IsRevoked() bool {
+	return s.RevokedAt != nil
+}
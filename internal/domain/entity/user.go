@@ -14,14 +14,20 @@ const (
 )
 
 type User struct {
-	ID           uuid.UUID  `json:"id" db:"id"`
-	Email        string     `json:"email" db:"email" validate:"required,email"`
-	Name         string     `json:"name" db:"name" validate:"required,min=1,max=255"`
-	PasswordHash string     `json:"-" db:"password_hash" validate:"required"`
-	AvatarURL    *string    `json:"avatar_url" db:"avatar_url"`
-	SystemRole   SystemRole `json:"system_role" db:"system_role" validate:"required,oneof=SUPER_ADMIN USER"`
-	CreatedAt    time.Time  `json:"created_at" db:"created_at"`
-	UpdatedAt    time.Time  `json:"updated_at" db:"updated_at"`
+	ID              uuid.UUID  `json:"id" db:"id"`
+	Email           string     `json:"email" db:"email" validate:"required,email"`
+	Name            string     `json:"name" db:"name" validate:"required,min=1,max=255"`
+	PasswordHash    string     `json:"-" db:"password_hash" validate:"required"`
+	AvatarURL       *string    `json:"avatar_url" db:"avatar_url"`
+	SystemRole      SystemRole `json:"system_role" db:"system_role" validate:"required,oneof=SUPER_ADMIN USER"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at" db:"email_verified_at"`
+	// EmailVerifiedBackfilled marks accounts that predate email verification
+	// and were marked verified without proving their address.
+	EmailVerifiedBackfilled bool       `json:"-" db:"email_verified_backfilled"`
+	SuspendedAt             *time.Time `json:"suspended_at" db:"suspended_at"`
+	PasswordResetRequired   bool       `json:"password_reset_required" db:"password_reset_required"`
+	CreatedAt               time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt               time.Time  `json:"updated_at" db:"updated_at"`
 }
 
 func (User) TableName() string {
@@ -39,3 +45,23 @@ func (u *User) IsSuperAdmin() bool {
 func (u *User) IsRegularUser() bool {
 	return u.SystemRole == SystemRoleUser
 }
+
+func (u *User) IsEmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
+// HasProvenEmail is stricter than IsEmailVerified: backfilled verification
+// lets legacy accounts log in but never grants access to workspaces.
+func (u *User) HasProvenEmail() bool {
+	return u.EmailVerifiedAt != nil && !u.EmailVerifiedBackfilled
+}
+
+func (u *User) IsSuspended() bool {
+	return u.SuspendedAt != nil
+}
+
+// HasPassword is false for accounts provisioned through single sign-on that
+// never set a password.
+func (u *User) HasPassword() bool {
+	return u.PasswordHash != ""
+}
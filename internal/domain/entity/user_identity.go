@@ -0,0 +1,27 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserIdentity links a user to an account at an external OpenID Connect
+// provider, identified by the provider's issuer and subject.
+type UserIdentity struct {
+	ID          uuid.UUID  `json:"id" db:"id"`
+	UserID      uuid.UUID  `json:"user_id" db:"user_id"`
+	Issuer      string     `json:"issuer" db:"issuer"`
+	Subject     string     `json:"subject" db:"subject"`
+	Email       string     `json:"email" db:"email"`
+	LastLoginAt *time.Time `json:"last_login_at" db:"last_login_at"`
+	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
+}
+
+func (UserIdentity) TableName() string {
+	return "user_identities"
+}
+
+func (i *UserIdentity) IsEmpty() bool {
+	return i.ID == uuid.Nil
+}
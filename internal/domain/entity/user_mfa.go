@@ -0,0 +1,24 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type UserMFA struct {
+	UserID          uuid.UUID  `json:"user_id" db:"user_id"`
+	Secret          string     `json:"-" db:"secret"`
+	ConfirmedAt     *time.Time `json:"confirmed_at" db:"confirmed_at"`
+	LastUsedCounter int64      `json:"-" db:"last_used_counter"`
+	CreatedAt       time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+func (UserMFA) TableName() string {
+	return "user_mfa"
+}
+
+func (m *UserMFA) IsConfirmed() bool {
+	return m.ConfirmedAt != nil
+}
@@ -23,6 +23,13 @@ type WorkspaceListItem struct {
 	BoardCount  uint          `json:"board_count"`
 }
 
+type WorkspaceSummary struct {
+	Workspace
+
+	MemberCount uint `json:"member_count"`
+	BoardCount  uint `json:"board_count"`
+}
+
 func (Workspace) TableName() string {
 	return "workspaces"
 }
@@ -30,3 +37,11 @@ func (Workspace) TableName() string {
 func (w *Workspace) IsEmpty() bool {
 	return w.ID == uuid.Nil
 }
+
+// WorkspaceDeletionSummary counts what was removed along with a workspace.
+type WorkspaceDeletionSummary struct {
+	Boards  int
+	Columns int
+	Cards   int
+	Members int
+}
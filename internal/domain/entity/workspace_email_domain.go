@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WorkspaceEmailDomain auto-joins users whose verified email is on Domain,
+// once the workspace has proven control of it. DefaultBoardIDs are boards
+// those users are added to as well.
+type WorkspaceEmailDomain struct {
+	ID                uuid.UUID   `json:"id" db:"id"`
+	WorkspaceID       uuid.UUID   `json:"workspace_id" db:"workspace_id"`
+	Domain            string      `json:"domain" db:"domain"`
+	VerificationToken string      `json:"-" db:"verification_token"`
+	DefaultBoardIDs   []uuid.UUID `json:"default_board_ids" db:"-"`
+	CreatedBy         *uuid.UUID  `json:"created_by" db:"created_by"`
+	VerifiedAt        *time.Time  `json:"verified_at" db:"verified_at"`
+	CreatedAt         time.Time   `json:"created_at" db:"created_at"`
+	UpdatedAt         time.Time   `json:"updated_at" db:"updated_at"`
+}
+
+func (WorkspaceEmailDomain) TableName() string {
+	return "workspace_email_domains"
+}
+
+func (d *WorkspaceEmailDomain) IsEmpty() bool {
+	return d.ID == uuid.Nil
+}
+
+func (d *WorkspaceEmailDomain) IsVerified() bool {
+	return d.VerifiedAt != nil
+}
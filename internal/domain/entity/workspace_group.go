@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WorkspaceGroup is a named set of workspace members that can be invited to
+// a board at once.
+type WorkspaceGroup struct {
+	ID          uuid.UUID  `json:"id" db:"id"`
+	WorkspaceID uuid.UUID  `json:"workspace_id" db:"workspace_id"`
+	Name        string     `json:"name" db:"name" validate:"required,min=1,max=100"`
+	Description *string    `json:"description" db:"description" validate:"omitempty,max=1000"`
+	CreatedBy   *uuid.UUID `json:"created_by" db:"created_by"`
+	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+type WorkspaceGroupListItem struct {
+	WorkspaceGroup
+
+	MemberCount uint `json:"member_count"`
+}
+
+type WorkspaceGroupMember struct {
+	GroupID uuid.UUID `json:"group_id" db:"group_id"`
+	UserID  uuid.UUID `json:"user_id" db:"user_id"`
+	AddedAt time.Time `json:"added_at" db:"added_at"`
+}
+
+func (WorkspaceGroup) TableName() string {
+	return "workspace_groups"
+}
+
+func (g *WorkspaceGroup) IsEmpty() bool {
+	return g.ID == uuid.Nil
+}
+
+func (WorkspaceGroupMember) TableName() string {
+	return "workspace_group_members"
+}
@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type InvitationStatus string
+
+const (
+	InvitationStatusPending  InvitationStatus = "PENDING"
+	InvitationStatusAccepted InvitationStatus = "ACCEPTED"
+	InvitationStatusRevoked  InvitationStatus = "REVOKED"
+)
+
+// WorkspaceInvitation invites an email address that has no account yet.
+// Membership is granted when the invitee registers or accepts the token.
+type WorkspaceInvitation struct {
+	ID          uuid.UUID        `json:"id" db:"id"`
+	WorkspaceID uuid.UUID        `json:"workspace_id" db:"workspace_id"`
+	Email       string           `json:"email" db:"email"`
+	Role        WorkspaceRole    `json:"role" db:"role"`
+	TokenHash   string           `json:"-" db:"token_hash"`
+	Status      InvitationStatus `json:"status" db:"status"`
+	InvitedBy   *uuid.UUID       `json:"invited_by" db:"invited_by"`
+	AcceptedBy  *uuid.UUID       `json:"accepted_by" db:"accepted_by"`
+	ExpiresAt   time.Time        `json:"expires_at" db:"expires_at"`
+	AcceptedAt  *time.Time       `json:"accepted_at" db:"accepted_at"`
+	CreatedAt   time.Time        `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time        `json:"updated_at" db:"updated_at"`
+}
+
+func (WorkspaceInvitation) TableName() string {
+	return "workspace_invitations"
+}
+
+func (wi *WorkspaceInvitation) IsEmpty() bool {
+	return wi.ID == uuid.Nil
+}
+
+func (wi *WorkspaceInvitation) IsExpired(now time.Time) bool {
+	return !now.Before(wi.ExpiresAt)
+}
+
+func (wi *WorkspaceInvitation) IsPending() bool {
+	return wi.Status == InvitationStatusPending
+}
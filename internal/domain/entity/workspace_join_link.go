@@ -0,0 +1,39 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WorkspaceJoinLink lets anyone holding its code join the workspace with
+// Role, until it expires, runs out of uses or is revoked.
+type WorkspaceJoinLink struct {
+	ID          uuid.UUID     `json:"id" db:"id"`
+	WorkspaceID uuid.UUID     `json:"workspace_id" db:"workspace_id"`
+	CodePrefix  string        `json:"code_prefix" db:"code_prefix"`
+	CodeHash    string        `json:"-" db:"code_hash"`
+	Role        WorkspaceRole `json:"role" db:"role"`
+	MaxUses     *int          `json:"max_uses" db:"max_uses"`
+	UseCount    int           `json:"use_count" db:"use_count"`
+	ExpiresAt   *time.Time    `json:"expires_at" db:"expires_at"`
+	CreatedBy   *uuid.UUID    `json:"created_by" db:"created_by"`
+	RevokedAt   *time.Time    `json:"revoked_at" db:"revoked_at"`
+	CreatedAt   time.Time     `json:"created_at" db:"created_at"`
+}
+
+func (WorkspaceJoinLink) TableName() string {
+	return "workspace_join_links"
+}
+
+func (l *WorkspaceJoinLink) IsEmpty() bool {
+	return l.ID == uuid.Nil
+}
+
+func (l *WorkspaceJoinLink) IsExpired(now time.Time) bool {
+	return l.ExpiresAt != nil && !now.Before(*l.ExpiresAt)
+}
+
+func (l *WorkspaceJoinLink) IsExhausted() bool {
+	return l.MaxUses != nil && l.UseCount >= *l.MaxUses
+}
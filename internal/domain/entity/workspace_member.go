@@ -11,12 +11,15 @@ type WorkspaceRole string
 const (
 	WorkspaceRoleAdmin  WorkspaceRole = "ADMIN"
 	WorkspaceRoleMember WorkspaceRole = "MEMBER"
+	// WorkspaceRoleGuest only reaches boards it was explicitly added to and
+	// cannot create boards or browse the member directory.
+	WorkspaceRoleGuest WorkspaceRole = "GUEST"
 )
 
 type WorkspaceMember struct {
 	WorkspaceID uuid.UUID     `json:"workspace_id" db:"workspace_id"`
 	UserID      uuid.UUID     `json:"user_id" db:"user_id"`
-	Role        WorkspaceRole `json:"role" db:"role" validate:"required,oneof=ADMIN MEMBER"`
+	Role        WorkspaceRole `json:"role" db:"role" validate:"required,oneof=ADMIN MEMBER GUEST"`
 	JoinedAt    time.Time     `json:"joined_at" db:"joined_at"`
 }
 
@@ -35,3 +38,23 @@ func (wm *WorkspaceMember) IsAdmin() bool {
 func (wm *WorkspaceMember) IsMember() bool {
 	return wm.Role == WorkspaceRoleMember
 }
+
+func (wm *WorkspaceMember) IsGuest() bool {
+	return wm.Role == WorkspaceRoleGuest
+}
+
+type WorkspaceMemberListItem struct {
+	WorkspaceMember
+
+	Email     string  `json:"email"`
+	Name      string  `json:"name"`
+	AvatarURL *string `json:"avatar_url"`
+}
+
+// WorkspaceLeaveSummary reports what was cleaned up when a member left a
+// workspace.
+type WorkspaceLeaveSummary struct {
+	RemovedBoardMemberships int
+	TransferredBoards       int
+	UnassignedCards         int
+}
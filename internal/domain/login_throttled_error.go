@@ -0,0 +1,17 @@
+package domain
+
+import "time"
+
+// LoginThrottledError carries how long the client has to wait before trying
+// to log in again. It matches ErrTooManyLoginAttempts with errors.Is.
+type LoginThrottledError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LoginThrottledError) Error() string {
+	return ErrTooManyLoginAttempts.Error()
+}
+
+func (e *LoginThrottledError) Unwrap() error {
+	return ErrTooManyLoginAttempts
+}
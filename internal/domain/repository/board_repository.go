@@ -15,4 +15,9 @@ type BoardRepository interface {
 	GetByID(ctx context.Context, boardID uuid.UUID) (*entity.Board, error)
 	GetUserBoardsInWorkspace(ctx context.Context, workspaceID, userID uuid.UUID) ([]*entity.BoardListItem, error)
 	SetArchived(ctx context.Context, boardID uuid.UUID, archived bool) error
+	// TransferOwnership makes toUserID, who must already be a board member,
+	// the creator and only BOARD_OWNER in one transaction; everyone else who
+	// was an owner becomes BOARD_MEMBER. It fails with ErrBoardOwnerChanged
+	// when fromUserID is no longer the creator.
+	TransferOwnership(ctx context.Context, boardID, fromUserID, toUserID uuid.UUID) (*entity.Board, error)
 }
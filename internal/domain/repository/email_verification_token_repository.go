@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"collabotask/internal/domain/entity"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type EmailVerificationTokenRepository interface {
+	Create(ctx context.Context, token *entity.EmailVerificationToken) error
+	GetByTokenHash(ctx context.Context, tokenHash string) (*entity.EmailVerificationToken, error)
+	MarkUsed(ctx context.Context, tokenID uuid.UUID) error
+	InvalidateByUser(ctx context.Context, userID uuid.UUID) error
+}
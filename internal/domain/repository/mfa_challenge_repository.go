@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"collabotask/internal/domain/entity"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type MFAChallengeRepository interface {
+	Create(ctx context.Context, challenge *entity.MFAChallenge) error
+	GetByTokenHash(ctx context.Context, tokenHash string) (*entity.MFAChallenge, error)
+	RegisterFailedAttempt(ctx context.Context, challengeID uuid.UUID) error
+	MarkUsed(ctx context.Context, challengeID uuid.UUID) error
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"collabotask/internal/domain/entity"
+	"context"
+)
+
+type OIDCLoginStateRepository interface {
+	Create(ctx context.Context, state *entity.OIDCLoginState) error
+	// Consume deletes and returns the state so a callback can only be
+	// completed once. Expired states left behind are purged on Create.
+	Consume(ctx context.Context, stateHash string) (*entity.OIDCLoginState, error)
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"collabotask/internal/domain/entity"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type PasswordResetTokenRepository interface {
+	Create(ctx context.Context, token *entity.PasswordResetToken) error
+	GetByTokenHash(ctx context.Context, tokenHash string) (*entity.PasswordResetToken, error)
+	MarkUsed(ctx context.Context, tokenID uuid.UUID) error
+	InvalidateByUser(ctx context.Context, userID uuid.UUID) error
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"collabotask/internal/domain/entity"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PersonalAccessTokenRepository interface {
+	Create(ctx context.Context, token *entity.PersonalAccessToken) error
+	GetByTokenHash(ctx context.Context, tokenHash string) (*entity.PersonalAccessToken, error)
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]*entity.PersonalAccessToken, error)
+	Revoke(ctx context.Context, id, userID uuid.UUID) error
+	RevokeAllByUser(ctx context.Context, userID uuid.UUID) error
+	TouchLastUsed(ctx context.Context, id uuid.UUID, usedAt time.Time) error
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"collabotask/internal/domain/entity"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type RefreshTokenRepository interface {
+	Create(ctx context.Context, token *entity.RefreshToken) error
+	GetByTokenHash(ctx context.Context, tokenHash string) (*entity.RefreshToken, error)
+	Rotate(ctx context.Context, usedTokenID uuid.UUID, newToken *entity.RefreshToken) error
+	RevokeFamily(ctx context.Context, familyID uuid.UUID) error
+	RevokeAllByUser(ctx context.Context, userID uuid.UUID) error
+}
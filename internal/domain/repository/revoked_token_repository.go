@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RevokedTokenRepository interface {
+	Revoke(ctx context.Context, tokenID string, userID uuid.UUID, expiresAt time.Time) error
+	IsRevoked(ctx context.Context, tokenID string) (bool, error)
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"collabotask/internal/domain/entity"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SessionRepository interface {
+	// Touch records activity on the session, creating it when it does not
+	// exist yet. Revoked sessions are left untouched.
+	Touch(ctx context.Context, session *entity.Session) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Session, error)
+	// ListActiveByUser returns the sessions that are not revoked and were
+	// seen since the given time, most recently seen first.
+	ListActiveByUser(ctx context.Context, userID uuid.UUID, seenSince time.Time) ([]*entity.Session, error)
+	Revoke(ctx context.Context, id, userID uuid.UUID) error
+	RevokeAllByUser(ctx context.Context, userID uuid.UUID) error
+}
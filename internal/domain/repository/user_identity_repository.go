@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"collabotask/internal/domain/entity"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type UserIdentityRepository interface {
+	Create(ctx context.Context, identity *entity.UserIdentity) error
+	GetBySubject(ctx context.Context, issuer, subject string) (*entity.UserIdentity, error)
+	TouchLastLogin(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"collabotask/internal/domain/entity"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type UserMFARepository interface {
+	// UpsertPending stores a new unconfirmed secret, replacing any earlier
+	// unconfirmed one. It fails with ErrMFAAlreadyEnabled once MFA is confirmed.
+	UpsertPending(ctx context.Context, mfa *entity.UserMFA) error
+	GetByUserID(ctx context.Context, userID uuid.UUID) (*entity.UserMFA, error)
+	// Confirm enables MFA and stores the initial recovery codes atomically.
+	Confirm(ctx context.Context, userID uuid.UUID, counter int64, confirmedAt time.Time, recoveryCodeHashes []string) error
+	// AdvanceCounter records the time step of an accepted code. Codes for a
+	// step that is not newer than the last accepted one are rejected as replays.
+	AdvanceCounter(ctx context.Context, userID uuid.UUID, counter int64) error
+	ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, recoveryCodeHashes []string) error
+	UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) error
+	CountUnusedRecoveryCodes(ctx context.Context, userID uuid.UUID) (int, error)
+	Delete(ctx context.Context, userID uuid.UUID) error
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"collabotask/internal/domain/entity"
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -12,8 +13,24 @@ type UserRepository interface {
 	GetById(ctx context.Context, id uuid.UUID) (*entity.User, error)
 	GetByEmail(ctx context.Context, email string) (*entity.User, error)
 	GetByIds(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*entity.User, error)
+	// Update only overwrites the non-zero fields of user. A nil AvatarURL
+	// keeps the stored avatar while an empty one clears it.
 	Update(ctx context.Context, user *entity.User) error
 	Delete(ctx context.Context, id uuid.UUID) error
-	List(ctx context.Context, limit, offset int) ([]*entity.User, error)
+	// List and Count skip deleted accounts. A non-empty search matches email
+	// prefixes and name substrings, case-insensitively.
+	List(ctx context.Context, search string, limit, offset int) ([]*entity.User, error)
+	Count(ctx context.Context, search string) (int, error)
 	ExistsByEmail(ctx context.Context, email string) (bool, error)
+	GetTokensValidAfter(ctx context.Context, id uuid.UUID) (*time.Time, error)
+	SetTokensValidAfter(ctx context.Context, id uuid.UUID, validAfter time.Time) error
+	MarkEmailVerified(ctx context.Context, id uuid.UUID, verifiedAt time.Time) error
+	// SetSuspended suspends the user at suspendedAt, or lifts the suspension
+	// when it is nil.
+	SetSuspended(ctx context.Context, id uuid.UUID, suspendedAt *time.Time) error
+	SetPasswordResetRequired(ctx context.Context, id uuid.UUID, required bool) error
+	// DeleteAccount hands owned workspaces and boards over to the next
+	// admin/owner (deleting workspaces nobody else belongs to), drops the
+	// user's memberships and anonymises the row so authored history stays.
+	DeleteAccount(ctx context.Context, id uuid.UUID, deletedAt time.Time) (*entity.AccountDeletionSummary, error)
 }
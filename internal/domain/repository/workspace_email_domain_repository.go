@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"collabotask/internal/domain/entity"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WorkspaceEmailDomainRepository interface {
+	// Create stores the domain with its default boards. Boards outside the
+	// workspace fail with ErrBoardNotFound.
+	Create(ctx context.Context, domain *entity.WorkspaceEmailDomain) error
+	GetByID(ctx context.Context, id, workspaceID uuid.UUID) (*entity.WorkspaceEmailDomain, error)
+	ListByWorkspace(ctx context.Context, workspaceID uuid.UUID) ([]*entity.WorkspaceEmailDomain, error)
+	SetDefaultBoards(ctx context.Context, id, workspaceID uuid.UUID, boardIDs []uuid.UUID) error
+	MarkVerified(ctx context.Context, id, workspaceID uuid.UUID, verifiedAt time.Time) error
+	Delete(ctx context.Context, id, workspaceID uuid.UUID) error
+	// JoinUser adds userID as a member of every workspace that verified
+	// emailDomain and has not auto-joined the user before, plus the default
+	// boards of those domains. It returns the number of workspaces joined.
+	JoinUser(ctx context.Context, userID uuid.UUID, emailDomain string, joinedAt time.Time) (int, error)
+}
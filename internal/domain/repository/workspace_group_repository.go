@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"collabotask/internal/domain/entity"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type WorkspaceGroupRepository interface {
+	// Create and Update fail with ErrGroupNameTaken when another group of the
+	// workspace has the same name, ignoring case.
+	Create(ctx context.Context, group *entity.WorkspaceGroup) error
+	GetByID(ctx context.Context, id, workspaceID uuid.UUID) (*entity.WorkspaceGroup, error)
+	ListByWorkspace(ctx context.Context, workspaceID uuid.UUID) ([]*entity.WorkspaceGroupListItem, error)
+	Update(ctx context.Context, group *entity.WorkspaceGroup) error
+	// Delete keeps the board memberships the group granted.
+	Delete(ctx context.Context, id, workspaceID uuid.UUID) error
+	ListMembers(ctx context.Context, groupID uuid.UUID) ([]*entity.WorkspaceGroupMember, error)
+	// AddMembers adds workspace members to the group and to its linked
+	// boards in one transaction. Users outside the workspace fail with
+	// ErrUserNotInWorkspace; existing group members are skipped. It returns
+	// the number of users added.
+	AddMembers(ctx context.Context, group *entity.WorkspaceGroup, userIDs []uuid.UUID) (int, error)
+	// RemoveMember removes the user from the group and from the linked
+	// boards it joined through a group, unless another linked group still
+	// covers that board.
+	RemoveMember(ctx context.Context, groupID, userID uuid.UUID) error
+	// AddToBoard adds the group's members to the board, skipping existing
+	// board members, and returns how many were added. With sync the group
+	// is linked to the board so later membership changes follow.
+	AddToBoard(ctx context.Context, groupID, boardID, linkedBy uuid.UUID, sync bool) (int, error)
+	// UnlinkBoard stops syncing; members already added stay on the board.
+	UnlinkBoard(ctx context.Context, groupID, boardID uuid.UUID) error
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"collabotask/internal/domain/entity"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WorkspaceInvitationRepository interface {
+	// Upsert stores a pending invitation, replacing the token, role and
+	// expiry of one already pending for the same workspace and email.
+	Upsert(ctx context.Context, invitation *entity.WorkspaceInvitation) error
+	GetByTokenHash(ctx context.Context, tokenHash string) (*entity.WorkspaceInvitation, error)
+	ListPendingByEmail(ctx context.Context, email string, now time.Time) ([]*entity.WorkspaceInvitation, error)
+	// Accept marks a pending invitation accepted and adds userID to the
+	// workspace with the invited role in one transaction. Existing
+	// memberships are left untouched.
+	Accept(ctx context.Context, invitationID, userID uuid.UUID, acceptedAt time.Time) error
+}
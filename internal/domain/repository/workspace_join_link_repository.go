@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"collabotask/internal/domain/entity"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WorkspaceJoinLinkRepository interface {
+	Create(ctx context.Context, link *entity.WorkspaceJoinLink) error
+	// ListActiveByWorkspace returns links that are neither revoked, expired
+	// nor used up at now.
+	ListActiveByWorkspace(ctx context.Context, workspaceID uuid.UUID, now time.Time) ([]*entity.WorkspaceJoinLink, error)
+	Revoke(ctx context.Context, id, workspaceID uuid.UUID) error
+	// Redeem counts a use of the active link with codeHash and adds userID to
+	// its workspace in one transaction. It fails with ErrInvalidJoinLink for
+	// unusable codes and ErrAlreadyMember, without using up the link, when
+	// the user already belongs to the workspace.
+	Redeem(ctx context.Context, codeHash string, userID uuid.UUID, now time.Time) (*entity.WorkspaceMember, error)
+}
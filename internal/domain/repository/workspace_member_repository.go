@@ -9,8 +9,47 @@ import (
 
 type WorkspaceMemberRepository interface {
 	Create(ctx context.Context, member *entity.WorkspaceMember) error
+	// CreateMany adds the members in one transaction and returns the ones
+	// inserted. Users that are already members are skipped.
+	CreateMany(ctx context.Context, members []*entity.WorkspaceMember) ([]*entity.WorkspaceMember, error)
 	Delete(ctx context.Context, workspaceID, userID uuid.UUID) error
 	GetByWorkspaceAndUser(ctx context.Context, workspaceID, userID uuid.UUID) (*entity.WorkspaceMember, error)
 	GetMembersByWorkspace(ctx context.Context, workspaceID uuid.UUID) ([]*entity.WorkspaceMember, error)
+	// ListMembers returns one page of members with their user details,
+	// ordered by filter.Sort and then by user id.
+	ListMembers(ctx context.Context, workspaceID uuid.UUID, filter WorkspaceMemberFilter) ([]*entity.WorkspaceMemberListItem, error)
 	IsUserExists(ctx context.Context, workspaceID, userID uuid.UUID) (bool, error)
+	// UpdateRole refuses, with ErrLastWorkspaceAdmin, to demote the owner or
+	// the last remaining admin, and with ErrGuestCannotOwnBoards to make a
+	// guest of someone who created or owns a board in the workspace. Callers
+	// check membership beforehand.
+	UpdateRole(ctx context.Context, workspaceID, userID uuid.UUID, role entity.WorkspaceRole) (*entity.WorkspaceMember, error)
+	// Leave removes the membership and the user's board memberships in the
+	// workspace in one transaction, optionally unassigning their cards there.
+	// Boards the user created are handed to a successor as in account
+	// deletion. The owner gets ErrOwnerCannotLeave.
+	Leave(ctx context.Context, workspaceID, userID uuid.UUID, unassignCards bool) (*entity.WorkspaceLeaveSummary, error)
+}
+
+type WorkspaceMemberSort string
+
+const (
+	WorkspaceMemberSortName     WorkspaceMemberSort = "name"
+	WorkspaceMemberSortEmail    WorkspaceMemberSort = "email"
+	WorkspaceMemberSortJoinedAt WorkspaceMemberSort = "joined_at"
+)
+
+type WorkspaceMemberFilter struct {
+	// Search matches a prefix of the name or email, case-insensitively.
+	Search string
+	// Role keeps only members with this role when set.
+	Role       entity.WorkspaceRole
+	Sort       WorkspaceMemberSort
+	Descending bool
+	// AfterValue and AfterUserID are the sort value and user id of the last
+	// member of the previous page; a nil AfterUserID starts from the top.
+	// Joined-at values use time.RFC3339Nano.
+	AfterValue  string
+	AfterUserID uuid.UUID
+	Limit       int
 }
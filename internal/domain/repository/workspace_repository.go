@@ -11,7 +11,18 @@ type WorkspaceRepository interface {
 	Create(ctx context.Context, workspace *entity.Workspace) error
 	CreateWithOwner(ctx context.Context, workspace *entity.Workspace, ownerID uuid.UUID) error
 	Update(ctx context.Context, workspace *entity.Workspace) error
-	Delete(ctx context.Context, workspaceID uuid.UUID) error
+	// Delete removes the workspace together with its boards, columns, cards
+	// and memberships, and reports how many of each were removed.
+	Delete(ctx context.Context, workspaceID uuid.UUID) (*entity.WorkspaceDeletionSummary, error)
 	GetByID(ctx context.Context, workspaceID uuid.UUID) (*entity.Workspace, error)
+	// TransferOwnership moves owner_id from fromUserID to toUserID and makes
+	// the new owner an admin in one transaction. It fails with
+	// ErrNotWorkspaceOwner if fromUserID no longer owns the workspace and
+	// ErrMemberNotFound if toUserID is not a member.
+	TransferOwnership(ctx context.Context, workspaceID, fromUserID, toUserID uuid.UUID) (*entity.Workspace, error)
 	GetUserWorkspaces(ctx context.Context, userID uuid.UUID) ([]*entity.WorkspaceListItem, error)
+	// List and Count cover every workspace on the instance. A non-empty
+	// search matches name substrings, case-insensitively.
+	List(ctx context.Context, search string, limit, offset int) ([]*entity.WorkspaceSummary, error)
+	Count(ctx context.Context, search string) (int, error)
 }
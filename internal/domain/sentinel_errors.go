@@ -4,18 +4,86 @@ import "errors"
 
 var (
 	// Auth
-	ErrUserNotFound       = errors.New("user not found")
-	ErrEmailAlreadyExists = errors.New("email already exists")
-	ErrInvalidCredentials = errors.New("invalid email or password")
+	ErrUserNotFound          = errors.New("user not found")
+	ErrEmailAlreadyExists    = errors.New("email already exists")
+	ErrInvalidCredentials    = errors.New("invalid email or password")
+	ErrEmailNotVerified      = errors.New("email address is not verified")
+	ErrIncorrectPassword     = errors.New("current password is incorrect")
+	ErrPasswordNotSet        = errors.New("account has no password; confirm with a two-factor code or set a password through the password reset flow")
+	ErrAccountSuspended      = errors.New("account is suspended")
+	ErrPasswordResetRequired = errors.New("password reset is required")
+	ErrTooManyLoginAttempts  = errors.New("too many failed login attempts")
+
+	// MFA
+	ErrMFAAlreadyEnabled   = errors.New("two-factor authentication is already enabled")
+	ErrMFANotEnabled       = errors.New("two-factor authentication is not enabled")
+	ErrInvalidMFACode      = errors.New("invalid two-factor authentication code")
+	ErrInvalidMFAChallenge = errors.New("invalid or expired two-factor challenge")
+
+	// OIDC
+	ErrOIDCNotConfigured       = errors.New("single sign-on is not configured")
+	ErrInvalidOIDCState        = errors.New("invalid or expired single sign-on state")
+	ErrOIDCLoginFailed         = errors.New("single sign-on login failed")
+	ErrOIDCEmailNotVerified    = errors.New("identity provider did not return a verified email")
+	ErrOIDCProvisioningBlocked = errors.New("no account exists for this email")
+	ErrOIDCAccountNotVerified  = errors.New("an account with this email exists but its email is not verified; verify it before signing in with single sign-on")
+	ErrUserIdentityNotFound    = errors.New("user identity not found")
+
+	// Admin
+	ErrCannotSuspendYourself = errors.New("cannot suspend yourself")
+
+	// Token
+	ErrInvalidRefreshToken = errors.New("invalid or expired refresh token")
+	ErrRefreshTokenReused  = errors.New("refresh token has already been used")
+
+	ErrInvalidPasswordResetToken     = errors.New("invalid or expired password reset token")
+	ErrInvalidEmailVerificationToken = errors.New("invalid or expired email verification token")
+
+	ErrInvalidAccessToken  = errors.New("invalid, expired or revoked access token")
+	ErrAccessTokenNotFound = errors.New("access token not found")
+
+	ErrSessionNotFound = errors.New("session not found")
+	ErrSessionRevoked  = errors.New("session has been revoked")
+
+	// Avatar
+	ErrAvatarNotFound           = errors.New("avatar not found")
+	ErrAvatarTooLarge           = errors.New("avatar file is too large")
+	ErrAvatarDimensionsTooLarge = errors.New("avatar image dimensions are too large")
+	ErrUnsupportedAvatarType    = errors.New("avatar must be a PNG, JPEG or GIF image")
 
 	// Workspace
 	ErrMemberNotFound        = errors.New("member not found")
 	ErrUserNotInWorkspace    = errors.New("user not in workspace")
 	ErrAlreadyMember         = errors.New("user already in workspace")
 	ErrNotWorkspaceAdmin     = errors.New("requester is not workspace admin")
+	ErrWorkspaceGuest        = errors.New("not allowed for workspace guests")
 	ErrWorkspaceNotFound     = errors.New("workspace not found")
 	ErrCannotRemoveYourself  = errors.New("cannot remove yourself")
+	ErrNotWorkspaceOwner     = errors.New("requester is not workspace owner")
+	ErrCannotRemoveOwner     = errors.New("cannot remove the workspace owner")
+	ErrCannotDemoteOwner     = errors.New("workspace owner must remain an admin")
+	ErrLastWorkspaceAdmin    = errors.New("workspace must keep at least one admin")
+	ErrAlreadyWorkspaceOwner = errors.New("user is already the workspace owner")
 	ErrBoardOwnerCannotLeave = errors.New("board owner cannot leave without transferring ownership")
+	ErrGuestCannotOwnBoards  = errors.New("transfer the member's boards before making them a guest")
+	ErrWorkspaceNameMismatch = errors.New("confirmation does not match the workspace name")
+	ErrOwnerCannotLeave      = errors.New("workspace owner cannot leave without transferring ownership")
+	ErrInvalidMemberCursor   = errors.New("invalid cursor for this member listing")
+
+	ErrInvalidInvitation       = errors.New("invalid or expired invitation")
+	ErrInvitationEmailMismatch = errors.New("invitation was sent to a different email address")
+	ErrInvalidJoinLink         = errors.New("invalid, expired or used up join link")
+	ErrJoinLinkNotFound        = errors.New("join link not found")
+
+	ErrEmailDomainNotFound           = errors.New("email domain not found")
+	ErrEmailDomainAlreadyAdded       = errors.New("email domain already added to this workspace")
+	ErrInvalidEmailDomain            = errors.New("invalid email domain")
+	ErrEmailDomainVerificationFailed = errors.New("domain verification record not found")
+
+	ErrGroupNotFound          = errors.New("group not found")
+	ErrGroupNameTaken         = errors.New("a group with this name already exists in the workspace")
+	ErrGroupMemberNotFound    = errors.New("user is not in this group")
+	ErrBoardGroupLinkNotFound = errors.New("group is not linked to this board")
 
 	// Board
 	ErrBoardNotFound          = errors.New("board not found")
@@ -24,6 +92,8 @@ var (
 	ErrBoardAccessDenied      = errors.New("board access denied")
 	ErrBoardPermissionDenied  = errors.New("board permission denied")
 	ErrBoardCannotJoin        = errors.New("cannot join board, permission denied")
+	ErrAlreadyBoardOwner      = errors.New("user is already the board owner")
+	ErrBoardOwnerChanged      = errors.New("board owner changed, reload and try again")
 	ErrBoardNoMembersToInvite = errors.New("no members were added to the board")
 
 	// Column
@@ -0,0 +1,30 @@
+package dto
+
+import (
+	"collabotask/internal/domain/entity"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AccessTokenDTO struct {
+	ID          uuid.UUID
+	Name        string
+	TokenPrefix string
+	Scope       entity.AccessTokenScope
+	ExpiresAt   *time.Time
+	LastUsedAt  *time.Time
+	CreatedAt   time.Time
+}
+
+func AccessTokenToDTO(token *entity.PersonalAccessToken) AccessTokenDTO {
+	return AccessTokenDTO{
+		ID:          token.ID,
+		Name:        token.Name,
+		TokenPrefix: token.TokenPrefix,
+		Scope:       token.Scope,
+		ExpiresAt:   token.ExpiresAt,
+		LastUsedAt:  token.LastUsedAt,
+		CreatedAt:   token.CreatedAt,
+	}
+}
@@ -0,0 +1,28 @@
+package dto
+
+import (
+	"collabotask/internal/domain/entity"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SessionDTO struct {
+	ID         uuid.UUID
+	UserAgent  string
+	IPAddress  string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	Current    bool
+}
+
+func SessionToDTO(session *entity.Session, currentSessionID uuid.UUID) SessionDTO {
+	return SessionDTO{
+		ID:         session.ID,
+		UserAgent:  session.UserAgent,
+		IPAddress:  session.IPAddress,
+		CreatedAt:  session.CreatedAt,
+		LastSeenAt: session.LastSeenAt,
+		Current:    session.ID == currentSessionID,
+	}
+}
@@ -2,24 +2,44 @@ package dto
 
 import (
 	"collabotask/internal/domain/entity"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type UserDTO struct {
-	ID         uuid.UUID `json:"id"`
-	Email      string    `json:"email"`
-	Name       string    `json:"name"`
-	AvatarURL  *string   `json:"avatar_url"`
-	SystemRole string    `json:"system_role"`
+	ID            uuid.UUID `json:"id"`
+	Email         string    `json:"email"`
+	Name          string    `json:"name"`
+	AvatarURL     *string   `json:"avatar_url"`
+	SystemRole    string    `json:"system_role"`
+	EmailVerified bool      `json:"email_verified"`
 }
 
 func UserToDTO(user *entity.User) UserDTO {
 	return UserDTO{
-		ID:         user.ID,
-		Email:      user.Email,
-		Name:       user.Name,
-		AvatarURL:  user.AvatarURL,
-		SystemRole: string(user.SystemRole),
+		ID:            user.ID,
+		Email:         user.Email,
+		Name:          user.Name,
+		AvatarURL:     user.AvatarURL,
+		SystemRole:    string(user.SystemRole),
+		EmailVerified: user.IsEmailVerified(),
+	}
+}
+
+type AdminUserDTO struct {
+	UserDTO
+
+	SuspendedAt           *time.Time
+	PasswordResetRequired bool
+	CreatedAt             time.Time
+}
+
+func UserToAdminDTO(user *entity.User) AdminUserDTO {
+	return AdminUserDTO{
+		UserDTO:               UserToDTO(user),
+		SuspendedAt:           user.SuspendedAt,
+		PasswordResetRequired: user.PasswordResetRequired,
+		CreatedAt:             user.CreatedAt,
 	}
 }
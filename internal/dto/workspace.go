@@ -24,6 +24,13 @@ type WorkspaceWithMetaDTO struct {
 	Role        entity.WorkspaceRole
 }
 
+type WorkspaceSummaryDTO struct {
+	WorkspaceDTO
+
+	MemberCount uint
+	BoardCount  uint
+}
+
 type WorkspaceMemberDTO struct {
 	UserID    uuid.UUID
 	Email     string
@@ -70,3 +77,33 @@ func WorkspaceMemberToDTO(member *entity.WorkspaceMember, user *entity.User) Wor
 		JoinedAt:  member.JoinedAt,
 	}
 }
+
+func WorkspaceMemberListItemToDTO(item *entity.WorkspaceMemberListItem) WorkspaceMemberDTO {
+	return WorkspaceMemberDTO{
+		UserID:    item.UserID,
+		Email:     item.Email,
+		Name:      item.Name,
+		AvatarURL: item.AvatarURL,
+		Role:      item.Role,
+		JoinedAt:  item.JoinedAt,
+	}
+}
+
+func WorkspaceSummaryToDTO(item *entity.WorkspaceSummary) WorkspaceSummaryDTO {
+	return WorkspaceSummaryDTO{
+		WorkspaceDTO: WorkspaceToDTO(&item.Workspace),
+		MemberCount:  item.MemberCount,
+		BoardCount:   item.BoardCount,
+	}
+}
+
+type WorkspaceEmailDomainDTO struct {
+	ID              uuid.UUID
+	Domain          string
+	Verified        bool
+	VerifiedAt      *time.Time
+	RecordName      string
+	RecordValue     string
+	DefaultBoardIDs []uuid.UUID
+	CreatedAt       time.Time
+}
@@ -0,0 +1,54 @@
+package dto
+
+import (
+	"collabotask/internal/domain/entity"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WorkspaceGroupDTO struct {
+	ID          uuid.UUID
+	Name        string
+	Description *string
+	MemberCount uint
+	CreatedBy   *uuid.UUID
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type WorkspaceGroupMemberDTO struct {
+	UserID    uuid.UUID
+	Email     string
+	Name      string
+	AvatarURL *string
+	AddedAt   time.Time
+}
+
+type WorkspaceGroupDetailDTO struct {
+	WorkspaceGroupDTO
+
+	Members []WorkspaceGroupMemberDTO
+}
+
+func WorkspaceGroupToDTO(group *entity.WorkspaceGroup, memberCount uint) WorkspaceGroupDTO {
+	return WorkspaceGroupDTO{
+		ID:          group.ID,
+		Name:        group.Name,
+		Description: group.Description,
+		MemberCount: memberCount,
+		CreatedBy:   group.CreatedBy,
+		CreatedAt:   group.CreatedAt,
+		UpdatedAt:   group.UpdatedAt,
+	}
+}
+
+func WorkspaceGroupMemberToDTO(member *entity.WorkspaceGroupMember, user *entity.User) WorkspaceGroupMemberDTO {
+	return WorkspaceGroupMemberDTO{
+		UserID:    member.UserID,
+		Email:     user.Email,
+		Name:      user.Name,
+		AvatarURL: user.AvatarURL,
+		AddedAt:   member.AddedAt,
+	}
+}
@@ -0,0 +1,32 @@
+package dto
+
+import (
+	"collabotask/internal/domain/entity"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WorkspaceJoinLinkDTO struct {
+	ID         uuid.UUID
+	CodePrefix string
+	Role       entity.WorkspaceRole
+	MaxUses    *int
+	UseCount   int
+	ExpiresAt  *time.Time
+	CreatedBy  *uuid.UUID
+	CreatedAt  time.Time
+}
+
+func WorkspaceJoinLinkToDTO(link *entity.WorkspaceJoinLink) WorkspaceJoinLinkDTO {
+	return WorkspaceJoinLinkDTO{
+		ID:         link.ID,
+		CodePrefix: link.CodePrefix,
+		Role:       link.Role,
+		MaxUses:    link.MaxUses,
+		UseCount:   link.UseCount,
+		ExpiresAt:  link.ExpiresAt,
+		CreatedBy:  link.CreatedBy,
+		CreatedAt:  link.CreatedAt,
+	}
+}
@@ -12,14 +12,17 @@ import (
 type TokenClaims struct {
 	UserID uuid.UUID `json:"user_id"`
 	Role   string    `json:"role"`
+	// SessionID is empty for tokens issued before sessions were tracked.
+	SessionID uuid.UUID `json:"sid"`
 	jwt.RegisteredClaims
 }
 
-func GenerateToken(cfg *config.AuthConfig, userID uuid.UUID, role string) (string, error) {
+func GenerateToken(cfg *config.AuthConfig, keys *JWTKeySet, userID, sessionID uuid.UUID, role string) (string, error) {
 	nowTime := time.Now()
 	claims := TokenClaims{
-		UserID: userID,
-		Role:   role,
+		UserID:    userID,
+		Role:      role,
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(nowTime.Add(cfg.JWTExpiration)),
 			IssuedAt:  jwt.NewNumericDate(nowTime),
@@ -28,8 +31,12 @@ func GenerateToken(cfg *config.AuthConfig, userID uuid.UUID, role string) (strin
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	signed, err := token.SignedString([]byte(cfg.JWTSecret))
+	token := jwt.NewWithClaims(keys.signingMethod, claims)
+	if keys.signingKeyID != "" {
+		token.Header["kid"] = keys.signingKeyID
+	}
+
+	signed, err := token.SignedString(keys.signingKey)
 	if err != nil {
 		return "", fmt.Errorf("failed to sign token: %w", err)
 	}
@@ -37,14 +44,8 @@ func GenerateToken(cfg *config.AuthConfig, userID uuid.UUID, role string) (strin
 	return signed, nil
 }
 
-func ValidateToken(cfg *config.AuthConfig, tokenString string) (*TokenClaims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &TokenClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return []byte(cfg.JWTSecret), nil
-	})
-
+func ValidateToken(keys *JWTKeySet, tokenString string) (*TokenClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &TokenClaims{}, keys.verificationKey, jwt.WithValidMethods(keys.validMethods()))
 	if err != nil {
 		return nil, fmt.Errorf("invalid token: %w", err)
 	}
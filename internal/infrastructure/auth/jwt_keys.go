@@ -0,0 +1,288 @@
+package auth
+
+import (
+	"collabotask/internal/config"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// JWTKeySet holds the key access tokens are signed with and every key they
+// may be verified with. Asymmetric keys are identified by their RFC 7638
+// thumbprint, which is written to the "kid" header; during a rotation the
+// previous public keys stay listed so tokens issued before it keep working.
+type JWTKeySet struct {
+	signingMethod jwt.SigningMethod
+	signingKey    any
+	signingKeyID  string
+
+	// hmacSecret keeps HS256 tokens verifiable, either because HS256 is the
+	// configured algorithm or while migrating away from it.
+	hmacSecret []byte
+	publicKeys map[string]crypto.PublicKey
+	keyOrder   []string
+}
+
+// JWK is the public JSON Web Key representation served from the JWKS
+// endpoint.
+type JWK struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	N         string `json:"n,omitempty"`
+	E         string `json:"e,omitempty"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+}
+
+func NewJWTKeySet(cfg *config.AuthConfig) (*JWTKeySet, error) {
+	keys := &JWTKeySet{publicKeys: make(map[string]crypto.PublicKey)}
+	if cfg.JWTSecret != "" {
+		keys.hmacSecret = []byte(cfg.JWTSecret)
+	}
+
+	switch cfg.JWTAlgorithm {
+	case "", "HS256":
+		if keys.hmacSecret == nil {
+			return nil, fmt.Errorf("jwt secret is required for HS256")
+		}
+		keys.signingMethod = jwt.SigningMethodHS256
+		keys.signingKey = keys.hmacSecret
+	case "RS256", "EdDSA":
+		privateKey, err := loadPrivateKey(cfg.JWTPrivateKeyFile)
+		if err != nil {
+			return nil, err
+		}
+
+		signer, ok := privateKey.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("jwt private key cannot sign")
+		}
+
+		switch privateKey.(type) {
+		case *rsa.PrivateKey:
+			if cfg.JWTAlgorithm != "RS256" {
+				return nil, fmt.Errorf("jwt private key is an RSA key but algorithm is %s", cfg.JWTAlgorithm)
+			}
+			keys.signingMethod = jwt.SigningMethodRS256
+		case ed25519.PrivateKey:
+			if cfg.JWTAlgorithm != "EdDSA" {
+				return nil, fmt.Errorf("jwt private key is an Ed25519 key but algorithm is %s", cfg.JWTAlgorithm)
+			}
+			keys.signingMethod = jwt.SigningMethodEdDSA
+		default:
+			return nil, fmt.Errorf("unsupported jwt private key type %T", privateKey)
+		}
+
+		keys.signingKey = privateKey
+		keys.signingKeyID, err = keys.addPublicKey(signer.Public())
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported jwt algorithm %q", cfg.JWTAlgorithm)
+	}
+
+	for _, path := range cfg.JWTPublicKeyFiles {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			continue
+		}
+
+		publicKey, err := loadPublicKey(path)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := keys.addPublicKey(publicKey); err != nil {
+			return nil, err
+		}
+	}
+
+	return keys, nil
+}
+
+// JWKS returns the public verification keys. HMAC secrets are never
+// published, so the set is empty when only HS256 is in use.
+func (k *JWTKeySet) JWKS() []JWK {
+	jwks := make([]JWK, 0, len(k.keyOrder))
+	for _, kid := range k.keyOrder {
+		jwk, err := publicKeyToJWK(k.publicKeys[kid])
+		if err != nil {
+			continue
+		}
+		jwk.KeyID = kid
+		jwks = append(jwks, jwk)
+	}
+
+	return jwks
+}
+
+func (k *JWTKeySet) addPublicKey(publicKey crypto.PublicKey) (string, error) {
+	jwk, err := publicKeyToJWK(publicKey)
+	if err != nil {
+		return "", err
+	}
+
+	kid := jwkThumbprint(jwk)
+	if _, exists := k.publicKeys[kid]; !exists {
+		k.publicKeys[kid] = publicKey
+		k.keyOrder = append(k.keyOrder, kid)
+	}
+
+	return kid, nil
+}
+
+func (k *JWTKeySet) validMethods() []string {
+	methods := make([]string, 0, 3)
+	if k.hmacSecret != nil {
+		methods = append(methods, jwt.SigningMethodHS256.Alg())
+	}
+	for _, kid := range k.keyOrder {
+		switch k.publicKeys[kid].(type) {
+		case *rsa.PublicKey:
+			methods = append(methods, jwt.SigningMethodRS256.Alg())
+		case ed25519.PublicKey:
+			methods = append(methods, jwt.SigningMethodEdDSA.Alg())
+		}
+	}
+
+	return methods
+}
+
+func (k *JWTKeySet) verificationKey(token *jwt.Token) (any, error) {
+	if _, ok := token.Method.(*jwt.SigningMethodHMAC); ok {
+		if k.hmacSecret == nil {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return k.hmacSecret, nil
+	}
+
+	kid, _ := token.Header["kid"].(string)
+	publicKey, ok := k.publicKeys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+
+	switch token.Method.(type) {
+	case *jwt.SigningMethodRSA:
+		if _, ok := publicKey.(*rsa.PublicKey); ok {
+			return publicKey, nil
+		}
+	case *jwt.SigningMethodEd25519:
+		if _, ok := publicKey.(ed25519.PublicKey); ok {
+			return publicKey, nil
+		}
+	}
+
+	return nil, fmt.Errorf("signing method %v does not match key %q", token.Header["alg"], kid)
+}
+
+func loadPrivateKey(path string) (crypto.PrivateKey, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("failed to parse private key %s", path)
+}
+
+// loadPublicKey also accepts a private key file and uses its public half.
+func loadPublicKey(path string) (crypto.PublicKey, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if key, err := x509.ParsePKIXPublicKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParsePKCS1PublicKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if privateKey, err := loadPrivateKey(path); err == nil {
+		if signer, ok := privateKey.(crypto.Signer); ok {
+			return signer.Public(), nil
+		}
+	}
+
+	return nil, fmt.Errorf("failed to parse public key %s", path)
+}
+
+func readPEM(path string) (*pem.Block, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key file: %w", err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found in %s", path)
+	}
+
+	return block, nil
+}
+
+func publicKeyToJWK(publicKey crypto.PublicKey) (JWK, error) {
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		return JWK{
+			KeyType:   "RSA",
+			Use:       "sig",
+			Algorithm: jwt.SigningMethodRS256.Alg(),
+			N:         base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:         base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}, nil
+	case ed25519.PublicKey:
+		return JWK{
+			KeyType:   "OKP",
+			Use:       "sig",
+			Algorithm: jwt.SigningMethodEdDSA.Alg(),
+			Curve:     "Ed25519",
+			X:         base64.RawURLEncoding.EncodeToString(key),
+		}, nil
+	default:
+		return JWK{}, fmt.Errorf("unsupported jwt public key type %T", publicKey)
+	}
+}
+
+// jwkThumbprint computes the RFC 7638 thumbprint from the required members
+// of the key in lexicographic order.
+func jwkThumbprint(jwk JWK) string {
+	var canonical []byte
+	switch jwk.KeyType {
+	case "RSA":
+		canonical, _ = json.Marshal(struct {
+			E   string `json:"e"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+		}{jwk.E, jwk.KeyType, jwk.N})
+	case "OKP":
+		canonical, _ = json.Marshal(struct {
+			Crv string `json:"crv"`
+			Kty string `json:"kty"`
+			X   string `json:"x"`
+		}{jwk.Curve, jwk.KeyType, jwk.X})
+	}
+
+	sum := sha256.Sum256(canonical)
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,106 @@
+package auth
+
+import (
+	"collabotask/internal/config"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func writeEd25519Key(t *testing.T, dir, name string) string {
+	t.Helper()
+
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatalf("failed to write key: %v", err)
+	}
+
+	return path
+}
+
+func TestJWTKeySetRotation(t *testing.T) {
+	dir := t.TempDir()
+	oldKey := writeEd25519Key(t, dir, "old.pem")
+	newKey := writeEd25519Key(t, dir, "new.pem")
+
+	oldCfg := &config.AuthConfig{JWTAlgorithm: "EdDSA", JWTPrivateKeyFile: oldKey, JWTExpiration: time.Minute}
+	oldKeys, err := NewJWTKeySet(oldCfg)
+	if err != nil {
+		t.Fatalf("NewJWTKeySet error: %v", err)
+	}
+
+	token, err := GenerateToken(oldCfg, oldKeys, uuid.New(), uuid.New(), "USER")
+	if err != nil {
+		t.Fatalf("GenerateToken error: %v", err)
+	}
+
+	rotatedCfg := &config.AuthConfig{
+		JWTAlgorithm:      "EdDSA",
+		JWTPrivateKeyFile: newKey,
+		JWTPublicKeyFiles: []string{oldKey},
+		JWTExpiration:     time.Minute,
+	}
+	rotatedKeys, err := NewJWTKeySet(rotatedCfg)
+	if err != nil {
+		t.Fatalf("NewJWTKeySet error: %v", err)
+	}
+
+	if _, err := ValidateToken(rotatedKeys, token); err != nil {
+		t.Errorf("expected token signed with the previous key to validate: %v", err)
+	}
+	if got := len(rotatedKeys.JWKS()); got != 2 {
+		t.Errorf("expected 2 keys in JWKS, got %d", got)
+	}
+
+	newOnlyKeys, err := NewJWTKeySet(&config.AuthConfig{JWTAlgorithm: "EdDSA", JWTPrivateKeyFile: newKey})
+	if err != nil {
+		t.Fatalf("NewJWTKeySet error: %v", err)
+	}
+	if _, err := ValidateToken(newOnlyKeys, token); err == nil {
+		t.Errorf("expected token signed with a dropped key to be rejected")
+	}
+}
+
+func TestJWTKeySetRejectsHMACWithoutSecret(t *testing.T) {
+	hmacCfg := &config.AuthConfig{JWTAlgorithm: "HS256", JWTSecret: "secret", JWTExpiration: time.Minute}
+	hmacKeys, err := NewJWTKeySet(hmacCfg)
+	if err != nil {
+		t.Fatalf("NewJWTKeySet error: %v", err)
+	}
+
+	token, err := GenerateToken(hmacCfg, hmacKeys, uuid.New(), uuid.New(), "USER")
+	if err != nil {
+		t.Fatalf("GenerateToken error: %v", err)
+	}
+
+	asymmetricKeys, err := NewJWTKeySet(&config.AuthConfig{
+		JWTAlgorithm:      "EdDSA",
+		JWTPrivateKeyFile: writeEd25519Key(t, t.TempDir(), "key.pem"),
+	})
+	if err != nil {
+		t.Fatalf("NewJWTKeySet error: %v", err)
+	}
+
+	if _, err := ValidateToken(asymmetricKeys, token); err == nil {
+		t.Errorf("expected HS256 token to be rejected when no secret is configured")
+	}
+	if len(hmacKeys.JWKS()) != 0 {
+		t.Errorf("expected HMAC secrets to stay out of the JWKS")
+	}
+}
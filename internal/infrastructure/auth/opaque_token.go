@@ -0,0 +1,53 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+const opaqueTokenBytes = 32
+
+// GenerateOpaqueToken returns a random URL-safe token together with the hash
+// that should be persisted in its place.
+func GenerateOpaqueToken() (token string, hash string, err error) {
+	b := make([]byte, opaqueTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	token = base64.RawURLEncoding.EncodeToString(b)
+	return token, HashOpaqueToken(token), nil
+}
+
+func HashOpaqueToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// PersonalAccessTokenPrefix marks personal access tokens so they can be told
+// apart from JWTs in the Authorization header.
+const PersonalAccessTokenPrefix = "ctpat_"
+
+// personalAccessTokenDisplayLen is how much of a personal access token is
+// kept in clear text so users can recognise it later.
+const personalAccessTokenDisplayLen = len(PersonalAccessTokenPrefix) + 8
+
+// GeneratePersonalAccessToken returns a new personal access token, its
+// displayable prefix and the hash to persist.
+func GeneratePersonalAccessToken() (token string, displayPrefix string, hash string, err error) {
+	raw, _, err := GenerateOpaqueToken()
+	if err != nil {
+		return "", "", "", err
+	}
+
+	token = PersonalAccessTokenPrefix + raw
+	return token, token[:personalAccessTokenDisplayLen], HashOpaqueToken(token), nil
+}
+
+func IsPersonalAccessToken(token string) bool {
+	return strings.HasPrefix(token, PersonalAccessTokenPrefix)
+}
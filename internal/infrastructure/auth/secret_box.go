@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+)
+
+// SealSecret encrypts a secret with AES-256-GCM so it can be stored at rest.
+// The key is derived from the configured passphrase with SHA-256.
+func SealSecret(passphrase, plaintext string) (string, error) {
+	gcm, err := newSecretCipher(passphrase)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	sealed := gcm.Seal(nonce, nonce, []byte(plaintext), nil)
+	return base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// OpenSecret reverses SealSecret.
+func OpenSecret(passphrase, ciphertext string) (string, error) {
+	gcm, err := newSecretCipher(passphrase)
+	if err != nil {
+		return "", err
+	}
+
+	sealed, err := base64.RawStdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode secret: %w", err)
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return "", fmt.Errorf("secret is too short")
+	}
+
+	nonce, data := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, data, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt secret: %w", err)
+	}
+
+	return string(plaintext), nil
+}
+
+func newSecretCipher(passphrase string) (cipher.AEAD, error) {
+	key := sha256.Sum256([]byte(passphrase))
+
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gcm: %w", err)
+	}
+
+	return gcm, nil
+}
@@ -0,0 +1,120 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	totpSecretBytes = 20
+	totpDigits      = 6
+	totpPeriod      = 30 * time.Second
+	// totpSkew is the number of periods accepted on either side of the
+	// current one to tolerate clock drift.
+	totpSkew = 1
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTOTPSecret returns a random base32 encoded secret suitable for
+// authenticator apps.
+func GenerateTOTPSecret() (string, error) {
+	b := make([]byte, totpSecretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate totp secret: %w", err)
+	}
+
+	return totpEncoding.EncodeToString(b), nil
+}
+
+// TOTPURI builds the otpauth:// URI that authenticator apps import, usually
+// through a QR code.
+func TOTPURI(issuer, accountName, secret string) string {
+	label := url.PathEscape(issuer) + ":" + url.PathEscape(accountName)
+
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(totpDigits))
+	query.Set("period", fmt.Sprint(int(totpPeriod.Seconds())))
+
+	return "otpauth://totp/" + label + "?" + query.Encode()
+}
+
+// GenerateTOTPCode computes the RFC 6238 code for the given time step.
+func GenerateTOTPCode(secret string, counter int64) (string, error) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(strings.TrimSpace(secret)))
+	if err != nil {
+		return "", fmt.Errorf("invalid totp secret: %w", err)
+	}
+
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(counter))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < totpDigits; i++ {
+		mod *= 10
+	}
+
+	return fmt.Sprintf("%0*d", totpDigits, value%mod), nil
+}
+
+// TOTPCounter returns the time step that t falls into.
+func TOTPCounter(t time.Time) int64 {
+	return t.Unix() / int64(totpPeriod.Seconds())
+}
+
+// ValidateTOTPCode checks code against the periods around now and returns
+// the matching time step so callers can reject replays.
+func ValidateTOTPCode(secret, code string, now time.Time) (int64, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != totpDigits {
+		return 0, false
+	}
+
+	current := TOTPCounter(now)
+	for counter := current - totpSkew; counter <= current+totpSkew; counter++ {
+		expected, err := GenerateTOTPCode(secret, counter)
+		if err != nil {
+			return 0, false
+		}
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return counter, true
+		}
+	}
+
+	return 0, false
+}
+
+// GenerateRecoveryCode returns a one-time recovery code formatted as two
+// dash separated groups, e.g. "k3xq7m2a-9fjd0wpe".
+func GenerateRecoveryCode() (string, error) {
+	b := make([]byte, 10)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate recovery code: %w", err)
+	}
+
+	code := strings.ToLower(totpEncoding.EncodeToString(b))
+	return code[:8] + "-" + code[8:], nil
+}
+
+// NormalizeRecoveryCode makes user input comparable with stored codes.
+func NormalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), " ", ""))
+}
@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+)
+
+// RFC 6238 appendix B vectors for SHA1, truncated to six digits.
+func TestGenerateTOTPCode(t *testing.T) {
+	secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+
+	for _, tt := range tests {
+		got, err := GenerateTOTPCode(secret, TOTPCounter(time.Unix(tt.unix, 0)))
+		if err != nil {
+			t.Fatalf("GenerateTOTPCode(%d) error: %v", tt.unix, err)
+		}
+		if got != tt.want {
+			t.Errorf("GenerateTOTPCode(%d) = %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestValidateTOTPCode(t *testing.T) {
+	secret, err := GenerateTOTPSecret()
+	if err != nil {
+		t.Fatalf("GenerateTOTPSecret error: %v", err)
+	}
+
+	now := time.Unix(1700000000, 0)
+	previous, _ := GenerateTOTPCode(secret, TOTPCounter(now)-1)
+
+	counter, ok := ValidateTOTPCode(secret, previous, now)
+	if !ok || counter != TOTPCounter(now)-1 {
+		t.Errorf("expected code from the previous period to be accepted")
+	}
+
+	stale, _ := GenerateTOTPCode(secret, TOTPCounter(now)-3)
+	if _, ok := ValidateTOTPCode(secret, stale, now); ok {
+		t.Errorf("expected code from three periods ago to be rejected")
+	}
+
+	if _, ok := ValidateTOTPCode(secret, "12345", now); ok {
+		t.Errorf("expected short code to be rejected")
+	}
+}
+
+func TestTOTPURI(t *testing.T) {
+	uri := TOTPURI("Collabotask", "jane@example.com", "ABC")
+
+	if !strings.HasPrefix(uri, "otpauth://totp/Collabotask:jane@example.com?") {
+		t.Errorf("unexpected uri prefix: %s", uri)
+	}
+	if !strings.Contains(uri, "secret=ABC") || !strings.Contains(uri, "issuer=Collabotask") {
+		t.Errorf("uri is missing secret or issuer: %s", uri)
+	}
+}
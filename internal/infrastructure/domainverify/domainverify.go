@@ -0,0 +1,93 @@
+// Package domainverify proves control of an email domain through a DNS TXT
+// record, the way most SaaS products verify a company domain.
+package domainverify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+)
+
+const (
+	recordLabel       = "_collabotask"
+	recordValuePrefix = "collabotask-verification="
+)
+
+var ErrInvalidDomain = errors.New("invalid email domain")
+
+var labelRegex = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// TXTResolver is the part of *net.Resolver the verifier needs.
+type TXTResolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+type Verifier struct {
+	resolver TXTResolver
+}
+
+// NewVerifier uses net.DefaultResolver when resolver is nil.
+func NewVerifier(resolver TXTResolver) *Verifier {
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+	return &Verifier{resolver: resolver}
+}
+
+// NormalizeDomain lowercases domain, drops a leading "@" or trailing dot, and
+// checks that it is a multi-label hostname.
+func NormalizeDomain(domain string) (string, error) {
+	domain = strings.TrimSpace(strings.ToLower(domain))
+	domain = strings.TrimPrefix(domain, "@")
+	domain = strings.TrimSuffix(domain, ".")
+
+	if len(domain) == 0 || len(domain) > 253 {
+		return "", ErrInvalidDomain
+	}
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
+		return "", ErrInvalidDomain
+	}
+	for _, label := range labels {
+		if !labelRegex.MatchString(label) {
+			return "", ErrInvalidDomain
+		}
+	}
+
+	return domain, nil
+}
+
+// RecordName is where the TXT record for domain must be published.
+func RecordName(domain string) string {
+	return recordLabel + "." + domain
+}
+
+// RecordValue is the TXT record content proving ownership with token.
+func RecordValue(token string) string {
+	return recordValuePrefix + token
+}
+
+// Verify reports whether the TXT record for token is published on domain. A
+// missing record is not an error.
+func (v *Verifier) Verify(ctx context.Context, domain, token string) (bool, error) {
+	records, err := v.resolver.LookupTXT(ctx, RecordName(domain))
+	if err != nil {
+		var dnsErr *net.DNSError
+		if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to look up verification record: %w", err)
+	}
+
+	want := RecordValue(token)
+	for _, record := range records {
+		if strings.TrimSpace(record) == want {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,59 @@
+package domainverify
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+)
+
+type fakeResolver map[string][]string
+
+func (f fakeResolver) LookupTXT(_ context.Context, name string) ([]string, error) {
+	records, ok := f[name]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+	}
+	return records, nil
+}
+
+func TestNormalizeDomain(t *testing.T) {
+	cases := map[string]string{
+		"OurCompany.com":       "ourcompany.com",
+		" @ourcompany.com ":    "ourcompany.com",
+		"mail.ourcompany.com.": "mail.ourcompany.com",
+	}
+	for in, want := range cases {
+		got, err := NormalizeDomain(in)
+		if err != nil || got != want {
+			t.Errorf("NormalizeDomain(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+
+	for _, in := range []string{"", "localhost", "-bad.com", "bad..com", "user@ourcompany.com", "under_score.com"} {
+		if _, err := NormalizeDomain(in); !errors.Is(err, ErrInvalidDomain) {
+			t.Errorf("NormalizeDomain(%q) err = %v, want ErrInvalidDomain", in, err)
+		}
+	}
+}
+
+func TestVerify(t *testing.T) {
+	v := NewVerifier(fakeResolver{
+		"_collabotask.ourcompany.com": {"v=spf1 -all", RecordValue("tok123")},
+	})
+
+	ok, err := v.Verify(context.Background(), "ourcompany.com", "tok123")
+	if err != nil || !ok {
+		t.Fatalf("Verify(matching record) = %v, %v; want true", ok, err)
+	}
+
+	ok, err = v.Verify(context.Background(), "ourcompany.com", "other")
+	if err != nil || ok {
+		t.Fatalf("Verify(wrong token) = %v, %v; want false", ok, err)
+	}
+
+	ok, err = v.Verify(context.Background(), "elsewhere.com", "tok123")
+	if err != nil || ok {
+		t.Fatalf("Verify(missing record) = %v, %v; want false, nil", ok, err)
+	}
+}
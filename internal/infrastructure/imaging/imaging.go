@@ -0,0 +1,129 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported image format")
+	ErrTooManyPixels     = errors.New("image dimensions are too large")
+)
+
+// formats maps sniffed content types to the decoder that must accept them.
+var formats = map[string]func([]byte) (image.Image, error){
+	"image/png":  func(b []byte) (image.Image, error) { return png.Decode(bytes.NewReader(b)) },
+	"image/jpeg": func(b []byte) (image.Image, error) { return jpeg.Decode(bytes.NewReader(b)) },
+	"image/gif":  func(b []byte) (image.Image, error) { return gif.Decode(bytes.NewReader(b)) },
+}
+
+var configDecoders = map[string]func([]byte) (image.Config, error){
+	"image/png":  func(b []byte) (image.Config, error) { return png.DecodeConfig(bytes.NewReader(b)) },
+	"image/jpeg": func(b []byte) (image.Config, error) { return jpeg.DecodeConfig(bytes.NewReader(b)) },
+	"image/gif":  func(b []byte) (image.Config, error) { return gif.DecodeConfig(bytes.NewReader(b)) },
+}
+
+// DetectContentType sniffs data and returns its MIME type if it is one of the
+// supported image formats. The declared type of an upload is never trusted.
+func DetectContentType(data []byte) (string, error) {
+	contentType := http.DetectContentType(data)
+	if _, ok := formats[contentType]; !ok {
+		return "", ErrUnsupportedFormat
+	}
+
+	return contentType, nil
+}
+
+// Decode sniffs and decodes a PNG, JPEG or GIF image. The header is checked
+// first so that oversized images are rejected before their pixels are
+// allocated. Only the first frame of an animated GIF is kept.
+func Decode(data []byte, maxPixels int) (image.Image, error) {
+	contentType, err := DetectContentType(data)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := configDecoders[contentType](data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedFormat, err)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 {
+		return nil, ErrUnsupportedFormat
+	}
+	if cfg.Width > maxPixels/cfg.Height {
+		return nil, ErrTooManyPixels
+	}
+
+	img, err := formats[contentType](data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedFormat, err)
+	}
+
+	return img, nil
+}
+
+// Thumbnail crops the centre square of src and scales it to size×size.
+// Downscaling averages every source pixel that falls into a target pixel,
+// which avoids the aliasing of nearest-neighbour sampling.
+func Thumbnail(src image.Image, size int) *image.NRGBA {
+	bounds := src.Bounds()
+	side := min(bounds.Dx(), bounds.Dy())
+	originX := bounds.Min.X + (bounds.Dx()-side)/2
+	originY := bounds.Min.Y + (bounds.Dy()-side)/2
+
+	dst := image.NewNRGBA(image.Rect(0, 0, size, size))
+	for dy := 0; dy < size; dy++ {
+		y0 := originY + dy*side/size
+		y1 := max(originY+(dy+1)*side/size, y0+1)
+
+		for dx := 0; dx < size; dx++ {
+			x0 := originX + dx*side/size
+			x1 := max(originX+(dx+1)*side/size, x0+1)
+
+			var r, g, b, a, n uint64
+			for y := y0; y < y1; y++ {
+				for x := x0; x < x1; x++ {
+					pr, pg, pb, pa := src.At(x, y).RGBA()
+					r += uint64(pr)
+					g += uint64(pg)
+					b += uint64(pb)
+					a += uint64(pa)
+					n++
+				}
+			}
+
+			// RGBA() is alpha-premultiplied; NRGBA is not.
+			pixel := color.NRGBA{}
+			if a > 0 {
+				pixel = color.NRGBA{
+					R: uint8(r * 0xffff / a >> 8),
+					G: uint8(g * 0xffff / a >> 8),
+					B: uint8(b * 0xffff / a >> 8),
+					A: uint8(a / n >> 8),
+				}
+			}
+			dst.SetNRGBA(dx, dy, pixel)
+		}
+	}
+
+	return dst
+}
+
+// EncodePNG encodes img as PNG, which keeps transparency and is what every
+// stored thumbnail uses.
+func EncodePNG(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := png.Encoder{CompressionLevel: png.BestCompression}
+	if err := encoder.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("failed to encode png: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
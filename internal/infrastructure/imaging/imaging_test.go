@@ -0,0 +1,74 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+)
+
+func encode(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("png.Encode: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestDecodeRejectsNonImages(t *testing.T) {
+	if _, err := Decode([]byte("<svg xmlns=\"http://www.w3.org/2000/svg\"></svg>"), 1000); !errors.Is(err, ErrUnsupportedFormat) {
+		t.Fatalf("Decode(svg) = %v, want ErrUnsupportedFormat", err)
+	}
+}
+
+func TestDecodeRejectsTooManyPixels(t *testing.T) {
+	data := encode(t, image.NewNRGBA(image.Rect(0, 0, 40, 30)))
+
+	if _, err := Decode(data, 40*30-1); !errors.Is(err, ErrTooManyPixels) {
+		t.Fatalf("Decode = %v, want ErrTooManyPixels", err)
+	}
+	if _, err := Decode(data, 40*30); err != nil {
+		t.Fatalf("Decode at limit: %v", err)
+	}
+}
+
+func TestThumbnailCropsCentreAndAverages(t *testing.T) {
+	// A 6x4 image: a red 1px border on the left and right that the square
+	// crop must drop, and a black/white checkerboard in the middle.
+	src := image.NewNRGBA(image.Rect(0, 0, 6, 4))
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 6; x++ {
+			c := color.NRGBA{R: 255, A: 255}
+			if x > 0 && x < 5 {
+				c = color.NRGBA{A: 255}
+				if (x+y)%2 == 0 {
+					c = color.NRGBA{R: 255, G: 255, B: 255, A: 255}
+				}
+			}
+			src.SetNRGBA(x, y, c)
+		}
+	}
+
+	thumb := Thumbnail(src, 2)
+	if thumb.Bounds().Dx() != 2 || thumb.Bounds().Dy() != 2 {
+		t.Fatalf("thumbnail bounds = %v", thumb.Bounds())
+	}
+	for y := 0; y < 2; y++ {
+		for x := 0; x < 2; x++ {
+			got := thumb.NRGBAAt(x, y)
+			if got.R != got.G || got.G != got.B || got.R < 120 || got.R > 135 || got.A != 255 {
+				t.Fatalf("pixel (%d,%d) = %v, want mid grey", x, y, got)
+			}
+		}
+	}
+}
+
+func TestThumbnailKeepsTransparency(t *testing.T) {
+	thumb := Thumbnail(image.NewNRGBA(image.Rect(0, 0, 8, 8)), 4)
+	if got := thumb.NRGBAAt(1, 1); got.A != 0 {
+		t.Fatalf("pixel = %v, want transparent", got)
+	}
+}
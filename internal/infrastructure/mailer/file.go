@@ -0,0 +1,65 @@
+package mailer
+
+import (
+	"collabotask/pkg/logger"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FileMailer writes every message as an .eml file into a directory, which is
+// handy for local development and tests.
+type FileMailer struct {
+	from string
+	dir  string
+}
+
+func NewFileMailer(from, dir string) (*FileMailer, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("mail file directory is required")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create mail directory: %w", err)
+	}
+
+	return &FileMailer{from: from, dir: dir}, nil
+}
+
+func (m *FileMailer) Send(ctx context.Context, msg Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%s_%s.eml", time.Now().UTC().Format("20060102T150405.000000000"), uuid.NewString())
+	if err := os.WriteFile(filepath.Join(m.dir, name), buildMessage(m.from, msg), 0o644); err != nil {
+		return fmt.Errorf("failed to write email: %w", err)
+	}
+
+	return nil
+}
+
+// LogMailer only logs outgoing messages.
+type LogMailer struct {
+	from string
+	log  *logger.Logger
+}
+
+func NewLogMailer(from string, log *logger.Logger) *LogMailer {
+	return &LogMailer{from: from, log: log}
+}
+
+func (m *LogMailer) Send(ctx context.Context, msg Message) error {
+	m.log.WithFields(map[string]interface{}{
+		"from":    m.from,
+		"to":      msg.To,
+		"subject": msg.Subject,
+		"body":    strings.TrimSpace(msg.Body),
+	}).Info("email")
+
+	return nil
+}
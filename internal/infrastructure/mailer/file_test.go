@@ -0,0 +1,45 @@
+package mailer
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestFileMailerSend(t *testing.T) {
+	dir := t.TempDir()
+
+	m, err := NewFileMailer("noreply@collabotask.local", dir)
+	if err != nil {
+		t.Fatalf("Failed to create file mailer: %v", err)
+	}
+
+	err = m.Send(context.Background(), Message{
+		To:      "user@example.com",
+		Subject: "Hello",
+		Body:    "Test body",
+	})
+	if err != nil {
+		t.Fatalf("Failed to send email: %v", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("Failed to read mail directory: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("Expected 1 email file, got %d", len(entries))
+	}
+
+	content, err := os.ReadFile(dir + "/" + entries[0].Name())
+	if err != nil {
+		t.Fatalf("Failed to read email file: %v", err)
+	}
+
+	for _, want := range []string{"To: user@example.com", "Subject: Hello", "Test body"} {
+		if !strings.Contains(string(content), want) {
+			t.Errorf("Expected email to contain %q", want)
+		}
+	}
+}
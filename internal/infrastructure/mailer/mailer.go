@@ -0,0 +1,37 @@
+package mailer
+
+import (
+	"collabotask/internal/config"
+	"collabotask/pkg/logger"
+	"context"
+	"fmt"
+)
+
+const (
+	DriverSMTP = "smtp"
+	DriverFile = "file"
+	DriverLog  = "log"
+)
+
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+func New(cfg *config.MailConfig, log *logger.Logger) (Mailer, error) {
+	switch cfg.Driver {
+	case DriverSMTP:
+		return NewSMTPMailer(cfg), nil
+	case DriverFile:
+		return NewFileMailer(cfg.From, cfg.FileDir)
+	case DriverLog, "":
+		return NewLogMailer(cfg.From, log), nil
+	default:
+		return nil, fmt.Errorf("unsupported mail driver: %s", cfg.Driver)
+	}
+}
@@ -0,0 +1,57 @@
+package mailer
+
+import (
+	"bytes"
+	"collabotask/internal/config"
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"time"
+)
+
+type SMTPMailer struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+func NewSMTPMailer(cfg *config.MailConfig) *SMTPMailer {
+	var auth smtp.Auth
+	if cfg.SMTPUsername != "" {
+		auth = smtp.PlainAuth("", cfg.SMTPUsername, cfg.SMTPPassword, cfg.SMTPHost)
+	}
+
+	return &SMTPMailer{
+		addr: net.JoinHostPort(cfg.SMTPHost, cfg.SMTPPort),
+		from: cfg.From,
+		auth: auth,
+	}
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, msg Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if err := smtp.SendMail(m.addr, m.auth, m.from, []string{msg.To}, buildMessage(m.from, msg)); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+
+	return nil
+}
+
+func buildMessage(from string, msg Message) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", msg.Subject)
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=\"utf-8\"\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(msg.Body)
+	buf.WriteString("\r\n")
+
+	return buf.Bytes()
+}
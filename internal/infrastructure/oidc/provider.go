@@ -0,0 +1,370 @@
+package oidc
+
+import (
+	"collabotask/internal/config"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	discoveryPath = "/.well-known/openid-configuration"
+	httpTimeout   = 10 * time.Second
+	// jwksRefreshInterval bounds how often an unknown "kid" triggers a
+	// refetch of the provider keys.
+	jwksRefreshInterval = time.Minute
+)
+
+// Identity is the verified subset of ID token claims the application uses.
+type Identity struct {
+	Issuer        string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+type Provider interface {
+	Issuer() string
+	// AuthCodeURL builds the authorization endpoint URL for the
+	// authorization-code flow with an S256 PKCE challenge.
+	AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error)
+	// Exchange redeems the authorization code and returns the identity from
+	// the verified ID token. The nonce must match the one sent with the
+	// authorization request.
+	Exchange(ctx context.Context, code, codeVerifier, nonce string) (*Identity, error)
+}
+
+type discoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type jsonWebKey struct {
+	KeyType string `json:"kty"`
+	KeyID   string `json:"kid"`
+	N       string `json:"n"`
+	E       string `json:"e"`
+	Curve   string `json:"crv"`
+	X       string `json:"x"`
+	Y       string `json:"y"`
+}
+
+type tokenResponse struct {
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+type idTokenClaims struct {
+	Nonce         string `json:"nonce"`
+	Email         string `json:"email"`
+	EmailVerified any    `json:"email_verified"`
+	Name          string `json:"name"`
+	jwt.RegisteredClaims
+}
+
+// Client talks to a single OpenID Connect provider. Discovery and keys are
+// loaded lazily so the API can start while the provider is unreachable.
+type Client struct {
+	cfg        *config.OIDCConfig
+	httpClient *http.Client
+
+	mu            sync.Mutex
+	discovery     *discoveryDocument
+	keys          map[string]crypto.PublicKey
+	keysFetchedAt time.Time
+}
+
+// New returns nil when OIDC is not configured.
+func New(cfg *config.OIDCConfig) Provider {
+	if cfg.IssuerURL == "" {
+		return nil
+	}
+
+	return NewClient(cfg, &http.Client{Timeout: httpTimeout})
+}
+
+func NewClient(cfg *config.OIDCConfig, httpClient *http.Client) *Client {
+	return &Client{cfg: cfg, httpClient: httpClient}
+}
+
+func (c *Client) Issuer() string {
+	return strings.TrimSuffix(c.cfg.IssuerURL, "/")
+}
+
+func (c *Client) AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error) {
+	doc, err := c.getDiscovery(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	query := url.Values{}
+	query.Set("response_type", "code")
+	query.Set("client_id", c.cfg.ClientID)
+	query.Set("redirect_uri", c.cfg.RedirectURL)
+	query.Set("scope", strings.Join(c.cfg.Scopes, " "))
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	query.Set("code_challenge", codeChallenge)
+	query.Set("code_challenge_method", "S256")
+
+	separator := "?"
+	if strings.Contains(doc.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+
+	return doc.AuthorizationEndpoint + separator + query.Encode(), nil
+}
+
+func (c *Client) Exchange(ctx context.Context, code, codeVerifier, nonce string) (*Identity, error) {
+	doc, err := c.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", c.cfg.RedirectURL)
+	form.Set("client_id", c.cfg.ClientID)
+	form.Set("code_verifier", codeVerifier)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, doc.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if c.cfg.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(c.cfg.ClientID), url.QueryEscape(c.cfg.ClientSecret))
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call token endpoint: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var token tokenResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&token); err != nil {
+		return nil, fmt.Errorf("failed to decode token response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK || token.Error != "" {
+		return nil, fmt.Errorf("token endpoint returned %d: %s %s", resp.StatusCode, token.Error, token.ErrorDescription)
+	}
+	if token.IDToken == "" {
+		return nil, fmt.Errorf("token response has no id_token")
+	}
+
+	return c.verifyIDToken(ctx, doc, token.IDToken, nonce)
+}
+
+func (c *Client) verifyIDToken(ctx context.Context, doc *discoveryDocument, rawIDToken, nonce string) (*Identity, error) {
+	claims := &idTokenClaims{}
+	_, err := jwt.ParseWithClaims(
+		rawIDToken,
+		claims,
+		func(token *jwt.Token) (any, error) {
+			kid, _ := token.Header["kid"].(string)
+			return c.getKey(ctx, doc, kid)
+		},
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512", "EdDSA"}),
+		jwt.WithIssuer(doc.Issuer),
+		jwt.WithAudience(c.cfg.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(time.Minute),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid id token: %w", err)
+	}
+
+	if claims.Nonce == "" || claims.Nonce != nonce {
+		return nil, fmt.Errorf("invalid id token: nonce mismatch")
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("invalid id token: missing subject")
+	}
+
+	return &Identity{
+		Issuer:        doc.Issuer,
+		Subject:       claims.Subject,
+		Email:         strings.TrimSpace(strings.ToLower(claims.Email)),
+		EmailVerified: isTrue(claims.EmailVerified),
+		Name:          strings.TrimSpace(claims.Name),
+	}, nil
+}
+
+func (c *Client) getDiscovery(ctx context.Context) (*discoveryDocument, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.discovery != nil {
+		return c.discovery, nil
+	}
+
+	doc := &discoveryDocument{}
+	if err := c.getJSON(ctx, c.Issuer()+discoveryPath, doc); err != nil {
+		return nil, fmt.Errorf("failed to load oidc discovery document: %w", err)
+	}
+	if strings.TrimSuffix(doc.Issuer, "/") != c.Issuer() {
+		return nil, fmt.Errorf("oidc discovery issuer %q does not match %q", doc.Issuer, c.Issuer())
+	}
+	if doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" || doc.JWKSURI == "" {
+		return nil, fmt.Errorf("oidc discovery document is incomplete")
+	}
+
+	c.discovery = doc
+	return doc, nil
+}
+
+func (c *Client) getKey(ctx context.Context, doc *discoveryDocument, kid string) (crypto.PublicKey, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if key, ok := c.lookupKey(kid); ok {
+		return key, nil
+	}
+	if !c.keysFetchedAt.IsZero() && time.Since(c.keysFetchedAt) < jwksRefreshInterval {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := c.getJSON(ctx, doc.JWKSURI, &set); err != nil {
+		return nil, fmt.Errorf("failed to load oidc signing keys: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if key, err := jwk.publicKey(); err == nil {
+			keys[jwk.KeyID] = key
+		}
+	}
+	c.keys = keys
+	c.keysFetchedAt = time.Now()
+
+	if key, ok := c.lookupKey(kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+// lookupKey falls back to the only key when the token carries no kid.
+func (c *Client) lookupKey(kid string) (crypto.PublicKey, bool) {
+	if key, ok := c.keys[kid]; ok {
+		return key, true
+	}
+	if kid == "" && len(c.keys) == 1 {
+		for _, key := range c.keys {
+			return key, true
+		}
+	}
+	return nil, false
+}
+
+func (c *Client) getJSON(ctx context.Context, endpoint string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from %s", resp.StatusCode, endpoint)
+	}
+
+	return json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(out)
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.KeyType {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Curve {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Curve)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	case "OKP":
+		if k.Curve != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Curve)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.KeyType)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+// isTrue accepts email_verified as a boolean or, as some providers send it,
+// a string.
+func isTrue(v any) bool {
+	switch value := v.(type) {
+	case bool:
+		return value
+	case string:
+		return value == "true"
+	default:
+		return false
+	}
+}
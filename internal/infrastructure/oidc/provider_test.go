@@ -0,0 +1,140 @@
+package oidc
+
+import (
+	"collabotask/internal/config"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// stubIdP is a minimal OpenID provider: it issues one authorization code per
+// call to authorize and signs ID tokens with a fresh RSA key.
+type stubIdP struct {
+	server    *httptest.Server
+	key       *rsa.PrivateKey
+	clientID  string
+	challenge string
+	nonce     string
+	email     string
+}
+
+func newStubIdP(t *testing.T) *stubIdP {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	idp := &stubIdP{key: key, clientID: "collabotask", email: "Jane@Example.com"}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 idp.server.URL,
+			"authorization_endpoint": idp.server.URL + "/authorize",
+			"token_endpoint":         idp.server.URL + "/token",
+			"jwks_uri":               idp.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": "stub",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+		if r.PostForm.Get("code") != "stub-code" || base64.RawURLEncoding.EncodeToString(sum[:]) != idp.challenge {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"iss":            idp.server.URL,
+			"aud":            idp.clientID,
+			"sub":            "user-123",
+			"exp":            time.Now().Add(time.Minute).Unix(),
+			"iat":            time.Now().Unix(),
+			"nonce":          idp.nonce,
+			"email":          idp.email,
+			"email_verified": true,
+			"name":           "Jane Doe",
+		})
+		token.Header["kid"] = "stub"
+		signed, _ := token.SignedString(key)
+		_ = json.NewEncoder(w).Encode(map[string]string{"id_token": signed})
+	})
+	idp.server = httptest.NewServer(mux)
+	t.Cleanup(idp.server.Close)
+
+	return idp
+}
+
+// authorize plays the part of the user approving the login.
+func (idp *stubIdP) authorize(t *testing.T, authURL string) {
+	t.Helper()
+
+	parsed, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatalf("invalid auth url: %v", err)
+	}
+	query := parsed.Query()
+	if query.Get("code_challenge_method") != "S256" || query.Get("client_id") != idp.clientID {
+		t.Fatalf("unexpected authorization request: %s", authURL)
+	}
+	idp.challenge = query.Get("code_challenge")
+	idp.nonce = query.Get("nonce")
+}
+
+func TestClientAuthorizationCodeFlow(t *testing.T) {
+	idp := newStubIdP(t)
+	client := NewClient(&config.OIDCConfig{
+		IssuerURL:   idp.server.URL,
+		ClientID:    idp.clientID,
+		RedirectURL: "http://localhost/callback",
+		Scopes:      []string{"openid", "email"},
+	}, idp.server.Client())
+
+	verifier, challenge, err := GeneratePKCE()
+	if err != nil {
+		t.Fatalf("GeneratePKCE error: %v", err)
+	}
+
+	authURL, err := client.AuthCodeURL(context.Background(), "state", "nonce-1", challenge)
+	if err != nil {
+		t.Fatalf("AuthCodeURL error: %v", err)
+	}
+	idp.authorize(t, authURL)
+
+	identity, err := client.Exchange(context.Background(), "stub-code", verifier, "nonce-1")
+	if err != nil {
+		t.Fatalf("Exchange error: %v", err)
+	}
+	if identity.Subject != "user-123" || identity.Email != "jane@example.com" || !identity.EmailVerified {
+		t.Errorf("unexpected identity: %+v", identity)
+	}
+
+	if _, err := client.Exchange(context.Background(), "stub-code", verifier, "other-nonce"); err == nil {
+		t.Errorf("expected nonce mismatch to be rejected")
+	}
+	if _, err := client.Exchange(context.Background(), "stub-code", "wrong-verifier", "nonce-1"); err == nil {
+		t.Errorf("expected wrong code verifier to be rejected")
+	}
+}
@@ -0,0 +1,127 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"os"
+	"path/filepath"
+)
+
+// LocalStore keeps objects as plain files below a base directory. The content
+// type is derived from the key's extension, so keys should carry one.
+type LocalStore struct {
+	dir string
+}
+
+func NewLocalStore(dir string) (*LocalStore, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("storage directory is required")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create storage directory: %w", err)
+	}
+
+	return &LocalStore{dir: dir}, nil
+}
+
+func (s *LocalStore) Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	name, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return fmt.Errorf("failed to create object directory: %w", err)
+	}
+
+	// Write to a temporary file first so readers never see a partial object.
+	tmp, err := os.CreateTemp(filepath.Dir(name), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create object: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, body); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write object: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write object: %w", err)
+	}
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return fmt.Errorf("failed to write object: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), name); err != nil {
+		return fmt.Errorf("failed to store object: %w", err)
+	}
+
+	return nil
+}
+
+func (s *LocalStore) Get(ctx context.Context, key string) (*Object, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	name, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(name)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to open object: %w", err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to stat object: %w", err)
+	}
+
+	contentType := mime.TypeByExtension(filepath.Ext(name))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	return &Object{
+		Body:        file,
+		ContentType: contentType,
+		Size:        info.Size(),
+		ModTime:     info.ModTime(),
+	}, nil
+}
+
+func (s *LocalStore) Delete(ctx context.Context, key string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	name, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(name); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+
+	return nil
+}
+
+func (s *LocalStore) path(key string) (string, error) {
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+
+	return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
@@ -0,0 +1,340 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	s3Algorithm       = "AWS4-HMAC-SHA256"
+	s3UnsignedPayload = "UNSIGNED-PAYLOAD"
+	s3TimeFormat      = "20060102T150405Z"
+	s3DateFormat      = "20060102"
+
+	// S3 rejects presigned URLs valid for longer than seven days.
+	s3MaxPresignTTL = 7 * 24 * time.Hour
+)
+
+type S3Options struct {
+	// Endpoint is the service base URL, e.g. https://s3.eu-west-1.amazonaws.com
+	// or http://localhost:9000 for MinIO.
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// ForcePathStyle addresses objects as endpoint/bucket/key instead of
+	// bucket.endpoint/key. Most self-hosted S3-compatible servers need it.
+	ForcePathStyle bool
+}
+
+// S3Store talks to any S3-compatible object store using Signature Version 4.
+type S3Store struct {
+	opts       S3Options
+	endpoint   *url.URL
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+func NewS3Store(opts S3Options, httpClient *http.Client) (*S3Store, error) {
+	if opts.Endpoint == "" || opts.Bucket == "" {
+		return nil, fmt.Errorf("s3 endpoint and bucket are required")
+	}
+	if opts.AccessKeyID == "" || opts.SecretAccessKey == "" {
+		return nil, fmt.Errorf("s3 credentials are required")
+	}
+	if opts.Region == "" {
+		opts.Region = "us-east-1"
+	}
+
+	endpoint, err := url.Parse(strings.TrimSuffix(opts.Endpoint, "/"))
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid s3 endpoint: %q", opts.Endpoint)
+	}
+
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: time.Minute}
+	}
+
+	return &S3Store{
+		opts:       opts,
+		endpoint:   endpoint,
+		httpClient: httpClient,
+		now:        time.Now,
+	}, nil
+}
+
+func (s *S3Store) Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error {
+	req, err := s.newRequest(ctx, http.MethodPut, key, body)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := s.do(req)
+	if err != nil {
+		return fmt.Errorf("failed to upload object: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return s3Error("upload", resp)
+	}
+
+	return nil
+}
+
+func (s *S3Store) Get(ctx context.Context, key string) (*Object, error) {
+	req, err := s.newRequest(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download object: %w", err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, ErrNotFound
+	default:
+		defer resp.Body.Close()
+		return nil, s3Error("download", resp)
+	}
+
+	modTime, _ := http.ParseTime(resp.Header.Get("Last-Modified"))
+
+	return &Object{
+		Body:        resp.Body,
+		ContentType: resp.Header.Get("Content-Type"),
+		Size:        resp.ContentLength,
+		ModTime:     modTime,
+	}, nil
+}
+
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	req, err := s.newRequest(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.do(req)
+	if err != nil {
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Deleting a missing key succeeds with 204 on S3 itself, but some
+	// compatible servers answer 404 instead.
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		return s3Error("delete", resp)
+	}
+
+	return nil
+}
+
+// SignedURL returns a presigned GET URL for key that expires after ttl.
+func (s *S3Store) SignedURL(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+	if ttl <= 0 || ttl > s3MaxPresignTTL {
+		ttl = s3MaxPresignTTL
+	}
+
+	return s.presign(http.MethodGet, s.objectURL(key), ttl, s.now().UTC()), nil
+}
+
+func (s *S3Store) newRequest(ctx context.Context, method, key string, body io.Reader) (*http.Request, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, s.objectURL(key).String(), body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create s3 request: %w", err)
+	}
+
+	return req, nil
+}
+
+func (s *S3Store) do(req *http.Request) (*http.Response, error) {
+	s.sign(req, s.now().UTC())
+	return s.httpClient.Do(req)
+}
+
+func (s *S3Store) objectURL(key string) *url.URL {
+	u := *s.endpoint
+	if s.opts.ForcePathStyle {
+		u.Path = u.Path + "/" + s.opts.Bucket + "/" + key
+	} else {
+		u.Host = s.opts.Bucket + "." + u.Host
+		u.Path = u.Path + "/" + key
+	}
+	u.RawPath = s3EscapePath(u.Path)
+
+	return &u
+}
+
+// sign adds an Authorization header. The payload is left unsigned so bodies
+// can be streamed; TLS protects them in transit.
+func (s *S3Store) sign(req *http.Request, now time.Time) {
+	amzDate := now.Format(s3TimeFormat)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", s3UnsignedPayload)
+
+	headers := map[string]string{
+		"host":                 req.URL.Host,
+		"x-amz-content-sha256": s3UnsignedPayload,
+		"x-amz-date":           amzDate,
+	}
+	if contentType := req.Header.Get("Content-Type"); contentType != "" {
+		headers["content-type"] = contentType
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(headers[name]) + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		s3CanonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		s3UnsignedPayload,
+	}, "\n")
+
+	scope := s.scope(now)
+	signature := s.signature(now, s.stringToSign(amzDate, scope, canonicalRequest))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, s.opts.AccessKeyID, scope, signedHeaders, signature,
+	))
+}
+
+func (s *S3Store) presign(method string, u *url.URL, ttl time.Duration, now time.Time) string {
+	amzDate := now.Format(s3TimeFormat)
+	scope := s.scope(now)
+
+	query := u.Query()
+	query.Set("X-Amz-Algorithm", s3Algorithm)
+	query.Set("X-Amz-Credential", s.opts.AccessKeyID+"/"+scope)
+	query.Set("X-Amz-Date", amzDate)
+	query.Set("X-Amz-Expires", strconv.Itoa(int(ttl/time.Second)))
+	query.Set("X-Amz-SignedHeaders", "host")
+
+	canonicalQuery := s3CanonicalQuery(query)
+	canonicalRequest := strings.Join([]string{
+		method,
+		u.EscapedPath(),
+		canonicalQuery,
+		"host:" + u.Host + "\n",
+		"host",
+		s3UnsignedPayload,
+	}, "\n")
+
+	signature := s.signature(now, s.stringToSign(amzDate, scope, canonicalRequest))
+
+	signed := *u
+	signed.RawQuery = canonicalQuery + "&X-Amz-Signature=" + signature
+
+	return signed.String()
+}
+
+func (s *S3Store) scope(now time.Time) string {
+	return now.Format(s3DateFormat) + "/" + s.opts.Region + "/s3/aws4_request"
+}
+
+func (s *S3Store) stringToSign(amzDate, scope, canonicalRequest string) string {
+	hash := sha256.Sum256([]byte(canonicalRequest))
+	return strings.Join([]string{s3Algorithm, amzDate, scope, hex.EncodeToString(hash[:])}, "\n")
+}
+
+func (s *S3Store) signature(now time.Time, stringToSign string) string {
+	key := hmacSHA256([]byte("AWS4"+s.opts.SecretAccessKey), now.Format(s3DateFormat))
+	key = hmacSHA256(key, s.opts.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// s3CanonicalQuery sorts parameters and escapes them the way SigV4 expects,
+// which differs from url.Values.Encode for spaces and a few reserved bytes.
+func s3CanonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		values := append([]string(nil), query[key]...)
+		sort.Strings(values)
+		for _, value := range values {
+			parts = append(parts, s3Escape(key, true)+"="+s3Escape(value, true))
+		}
+	}
+
+	return strings.Join(parts, "&")
+}
+
+func s3EscapePath(p string) string {
+	return s3Escape(p, false)
+}
+
+func s3Escape(s string, escapeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !escapeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+
+	return b.String()
+}
+
+func s3Error(action string, resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return fmt.Errorf("s3 %s failed: %s: %s", action, resp.Status, strings.TrimSpace(string(body)))
+}
@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"collabotask/internal/config"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+)
+
+const (
+	DriverLocal = "local"
+	DriverS3    = "s3"
+)
+
+var ErrNotFound = errors.New("object not found")
+
+// Object is a stored blob opened for reading. Callers must close Body.
+type Object struct {
+	Body        io.ReadCloser
+	ContentType string
+	Size        int64
+	ModTime     time.Time
+}
+
+// BlobStore keeps binary objects under slash-separated keys.
+type BlobStore interface {
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (*Object, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// URLSigner is implemented by stores that can hand out time-limited URLs so
+// clients download objects directly instead of through the API.
+type URLSigner interface {
+	SignedURL(ctx context.Context, key string, ttl time.Duration) (string, error)
+}
+
+func New(cfg *config.StorageConfig) (BlobStore, error) {
+	switch cfg.Driver {
+	case DriverLocal, "":
+		return NewLocalStore(cfg.LocalDir)
+	case DriverS3:
+		return NewS3Store(S3Options{
+			Endpoint:        cfg.S3Endpoint,
+			Region:          cfg.S3Region,
+			Bucket:          cfg.S3Bucket,
+			AccessKeyID:     cfg.S3AccessKeyID,
+			SecretAccessKey: cfg.S3SecretAccessKey,
+			ForcePathStyle:  cfg.S3ForcePathStyle,
+		}, nil)
+	default:
+		return nil, fmt.Errorf("unsupported storage driver: %s", cfg.Driver)
+	}
+}
+
+func validateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || path.Clean(key) != key || key == ".." || strings.HasPrefix(key, "../") {
+		return fmt.Errorf("invalid object key: %q", key)
+	}
+
+	return nil
+}
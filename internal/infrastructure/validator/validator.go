@@ -16,6 +16,10 @@ func Struct(s interface{}) error {
 	return validate.Struct(s)
 }
 
+func Var(field interface{}, tag string) error {
+	return validate.Var(field, tag)
+}
+
 func AtLeastOneProvided(ptrs ...interface{}) bool {
 	for _, p := range ptrs {
 		if p == nil {
@@ -8,13 +8,22 @@ import (
 	"collabotask/internal/adapter/repository/postgres"
 	"collabotask/internal/config"
 	"collabotask/internal/domain/repository"
+	infraauth "collabotask/internal/infrastructure/auth"
 	"collabotask/internal/infrastructure/database"
+	"collabotask/internal/infrastructure/domainverify"
+	"collabotask/internal/infrastructure/mailer"
+	"collabotask/internal/infrastructure/oidc"
+	"collabotask/internal/infrastructure/storage"
 	"collabotask/internal/server"
+	"collabotask/internal/usecase/accesstoken"
+	"collabotask/internal/usecase/admin"
 	"collabotask/internal/usecase/auth"
+	"collabotask/internal/usecase/avatar"
 	"collabotask/internal/usecase/board"
 	"collabotask/internal/usecase/card"
 	"collabotask/internal/usecase/column"
 	"collabotask/internal/usecase/common"
+	"collabotask/internal/usecase/session"
 	"collabotask/internal/usecase/workspace"
 	"collabotask/pkg/logger"
 )
@@ -34,6 +43,26 @@ func ProvideDB(cfg *config.Config) (*database.DB, error) {
 	return database.NewDB(cfg)
 }
 
+func ProvideJWTKeySet(cfg *config.Config) (*infraauth.JWTKeySet, error) {
+	return infraauth.NewJWTKeySet(&cfg.Auth)
+}
+
+func ProvideOIDCProvider(cfg *config.Config) oidc.Provider {
+	return oidc.New(&cfg.OIDC)
+}
+
+func ProvideMailer(cfg *config.Config, log *logger.Logger) (mailer.Mailer, error) {
+	return mailer.New(&cfg.Mail, log)
+}
+
+func ProvideBlobStore(cfg *config.Config) (storage.BlobStore, error) {
+	return storage.New(&cfg.Storage)
+}
+
+func ProvideDomainVerifier() *domainverify.Verifier {
+	return domainverify.NewVerifier(nil)
+}
+
 // Repository
 func ProvideUserRepository(db *database.DB) repository.UserRepository {
 	return postgres.NewUserRepository(db.Pool)
@@ -56,18 +85,119 @@ func ProvideColumnRepository(db *database.DB) repository.ColumnRepository {
 func ProvideCardRepository(db *database.DB) repository.CardRepository {
 	return postgres.NewCardRepository(db.Pool)
 }
+func ProvideRefreshTokenRepository(db *database.DB) repository.RefreshTokenRepository {
+	return postgres.NewRefreshTokenRepository(db.Pool)
+}
+func ProvideRevokedTokenRepository(db *database.DB) repository.RevokedTokenRepository {
+	return postgres.NewRevokedTokenRepository(db.Pool)
+}
+func ProvidePasswordResetTokenRepository(db *database.DB) repository.PasswordResetTokenRepository {
+	return postgres.NewPasswordResetTokenRepository(db.Pool)
+}
+func ProvideEmailVerificationTokenRepository(db *database.DB) repository.EmailVerificationTokenRepository {
+	return postgres.NewEmailVerificationTokenRepository(db.Pool)
+}
+func ProvidePersonalAccessTokenRepository(db *database.DB) repository.PersonalAccessTokenRepository {
+	return postgres.NewPersonalAccessTokenRepository(db.Pool)
+}
+func ProvideUserMFARepository(db *database.DB) repository.UserMFARepository {
+	return postgres.NewUserMFARepository(db.Pool)
+}
+func ProvideMFAChallengeRepository(db *database.DB) repository.MFAChallengeRepository {
+	return postgres.NewMFAChallengeRepository(db.Pool)
+}
+func ProvideUserIdentityRepository(db *database.DB) repository.UserIdentityRepository {
+	return postgres.NewUserIdentityRepository(db.Pool)
+}
+func ProvideOIDCLoginStateRepository(db *database.DB) repository.OIDCLoginStateRepository {
+	return postgres.NewOIDCLoginStateRepository(db.Pool)
+}
+func ProvideSessionRepository(db *database.DB) repository.SessionRepository {
+	return postgres.NewSessionRepository(db.Pool)
+}
+func ProvideWorkspaceInvitationRepository(db *database.DB) repository.WorkspaceInvitationRepository {
+	return postgres.NewWorkspaceInvitationRepository(db.Pool)
+}
+
+func ProvideWorkspaceJoinLinkRepository(db *database.DB) repository.WorkspaceJoinLinkRepository {
+	return postgres.NewWorkspaceJoinLinkRepository(db.Pool)
+}
+
+func ProvideWorkspaceEmailDomainRepository(db *database.DB) repository.WorkspaceEmailDomainRepository {
+	return postgres.NewWorkspaceEmailDomainRepository(db.Pool)
+}
+
+func ProvideWorkspaceGroupRepository(db *database.DB) repository.WorkspaceGroupRepository {
+	return postgres.NewWorkspaceGroupRepository(db.Pool)
+}
 
 // UseCase
-func ProvideAuthUseCase(userRepo repository.UserRepository, cfg *config.Config) auth.AuthUseCase {
-	return auth.NewAuthUseCase(userRepo, &cfg.Auth)
+func ProvideAuthUseCase(
+	userRepo repository.UserRepository,
+	refreshTokenRepo repository.RefreshTokenRepository,
+	passwordResetRepo repository.PasswordResetTokenRepository,
+	emailVerificationRepo repository.EmailVerificationTokenRepository,
+	userMFARepo repository.UserMFARepository,
+	mfaChallengeRepo repository.MFAChallengeRepository,
+	userIdentityRepo repository.UserIdentityRepository,
+	oidcStateRepo repository.OIDCLoginStateRepository,
+	sessionRepo repository.SessionRepository,
+	revocationStore common.TokenRevocationStore,
+	loginThrottler common.LoginThrottler,
+	invitationAcceptor common.WorkspaceInvitationAcceptor,
+	domainJoiner common.WorkspaceDomainJoiner,
+	mail mailer.Mailer,
+	oidcProvider oidc.Provider,
+	cfg *config.Config,
+	jwtKeys *infraauth.JWTKeySet,
+) auth.AuthUseCase {
+	return auth.NewAuthUseCase(
+		userRepo,
+		refreshTokenRepo,
+		passwordResetRepo,
+		emailVerificationRepo,
+		userMFARepo,
+		mfaChallengeRepo,
+		userIdentityRepo,
+		oidcStateRepo,
+		sessionRepo,
+		revocationStore,
+		loginThrottler,
+		invitationAcceptor,
+		domainJoiner,
+		mail,
+		oidcProvider,
+		&cfg.Auth,
+		&cfg.OIDC,
+		jwtKeys,
+	)
 }
 func ProvideWorkspaceUseCase(
 	workspaceRepo repository.WorkspaceRepository,
 	workspaceMemberRepo repository.WorkspaceMemberRepository,
 	userRepo repository.UserRepository,
-
+	invitationRepo repository.WorkspaceInvitationRepository,
+	joinLinkRepo repository.WorkspaceJoinLinkRepository,
+	emailDomainRepo repository.WorkspaceEmailDomainRepository,
+	groupRepo repository.WorkspaceGroupRepository,
+	invitationAcceptor common.WorkspaceInvitationAcceptor,
+	mail mailer.Mailer,
+	domainVerifier *domainverify.Verifier,
+	cfg *config.Config,
 ) workspace.WorkspaceUseCase {
-	return workspace.NewWorkspaceUseCase(workspaceRepo, workspaceMemberRepo, userRepo)
+	return workspace.NewWorkspaceUseCase(
+		workspaceRepo,
+		workspaceMemberRepo,
+		userRepo,
+		invitationRepo,
+		joinLinkRepo,
+		emailDomainRepo,
+		groupRepo,
+		invitationAcceptor,
+		mail,
+		domainVerifier,
+		&cfg.Auth,
+	)
 }
 func ProvideBoardUseCase(
 	boardRepo repository.BoardRepository,
@@ -77,8 +207,9 @@ func ProvideBoardUseCase(
 	userRepo repository.UserRepository,
 	columnRepo repository.ColumnRepository,
 	cardRepo repository.CardRepository,
+	groupRepo repository.WorkspaceGroupRepository,
 ) board.BoardUseCase {
-	return board.NewBoardUseCase(boardRepo, boardMemberRepo, workspaceRepo, workspaceMemberRepo, userRepo, columnRepo, cardRepo)
+	return board.NewBoardUseCase(boardRepo, boardMemberRepo, workspaceRepo, workspaceMemberRepo, userRepo, columnRepo, cardRepo, groupRepo)
 }
 func ProvideColumnUseCase(
 	columnRepo repository.ColumnRepository,
@@ -94,6 +225,32 @@ func ProvideCardUseCase(
 ) card.CardUseCase {
 	return card.NewCardUseCase(cardRepo, columnRepo, userRepo, boardAccessChecker)
 }
+func ProvideAdminUseCase(
+	userRepo repository.UserRepository,
+	workspaceRepo repository.WorkspaceRepository,
+	accessTokenRepo repository.PersonalAccessTokenRepository,
+	authUseCase auth.AuthUseCase,
+) admin.AdminUseCase {
+	return admin.NewAdminUseCase(userRepo, workspaceRepo, accessTokenRepo, authUseCase)
+}
+func ProvideSessionUseCase(
+	sessionRepo repository.SessionRepository,
+	refreshTokenRepo repository.RefreshTokenRepository,
+	revocationStore common.TokenRevocationStore,
+	cfg *config.Config,
+) session.SessionUseCase {
+	return session.NewSessionUseCase(sessionRepo, refreshTokenRepo, revocationStore, &cfg.Auth)
+}
+func ProvideAvatarUseCase(
+	userRepo repository.UserRepository,
+	blobStore storage.BlobStore,
+	cfg *config.Config,
+) avatar.AvatarUseCase {
+	return avatar.NewAvatarUseCase(userRepo, blobStore, &cfg.Storage)
+}
+func ProvideAccessTokenUseCase(accessTokenRepo repository.PersonalAccessTokenRepository) accesstoken.AccessTokenUseCase {
+	return accesstoken.NewAccessTokenUseCase(accessTokenRepo)
+}
 
 // Common use cases
 func ProvideBoardAccessChecker(
@@ -104,6 +261,34 @@ func ProvideBoardAccessChecker(
 	return common.NewBoardAccessChecker(boardRepo, boardMemberRepo, workspaceMemberRepo)
 }
 
+func ProvideTokenRevocationStore(
+	revokedTokenRepo repository.RevokedTokenRepository,
+	userRepo repository.UserRepository,
+	sessionRepo repository.SessionRepository,
+	cfg *config.Config,
+) common.TokenRevocationStore {
+	return common.NewTokenRevocationStore(revokedTokenRepo, userRepo, sessionRepo, cfg.Auth.RevocationCacheTTL)
+}
+
+func ProvideLoginThrottler(cfg *config.Config, log *logger.Logger) common.LoginThrottler {
+	return common.NewLoginThrottler(&cfg.Auth, log)
+}
+
+func ProvideWorkspaceInvitationAcceptor(invitationRepo repository.WorkspaceInvitationRepository) common.WorkspaceInvitationAcceptor {
+	return common.NewWorkspaceInvitationAcceptor(invitationRepo)
+}
+
+func ProvideWorkspaceDomainJoiner(emailDomainRepo repository.WorkspaceEmailDomainRepository) common.WorkspaceDomainJoiner {
+	return common.NewWorkspaceDomainJoiner(emailDomainRepo)
+}
+
+func ProvideAccessTokenAuthenticator(
+	accessTokenRepo repository.PersonalAccessTokenRepository,
+	userRepo repository.UserRepository,
+) common.AccessTokenAuthenticator {
+	return common.NewAccessTokenAuthenticator(accessTokenRepo, userRepo)
+}
+
 // Handler
 func ProvideAuthHandler(authUseCase auth.AuthUseCase) *handler.AuthHandler {
 	return handler.NewAuthHandler(authUseCase)
@@ -123,6 +308,24 @@ func ProvideColumnHandler(columnUseCase column.ColumnUseCase) *handler.ColumnHan
 func ProvideCardHandler(cardUseCase card.CardUseCase) *handler.CardHandler {
 	return handler.NewCardHandler(cardUseCase)
 }
+func ProvideAdminHandler(adminUseCase admin.AdminUseCase) *handler.AdminHandler {
+	return handler.NewAdminHandler(adminUseCase)
+}
+func ProvideAccessTokenHandler(accessTokenUseCase accesstoken.AccessTokenUseCase) *handler.AccessTokenHandler {
+	return handler.NewAccessTokenHandler(accessTokenUseCase)
+}
+func ProvideMFAHandler(authUseCase auth.AuthUseCase) *handler.MFAHandler {
+	return handler.NewMFAHandler(authUseCase)
+}
+func ProvideSessionHandler(sessionUseCase session.SessionUseCase) *handler.SessionHandler {
+	return handler.NewSessionHandler(sessionUseCase)
+}
+func ProvideAvatarHandler(avatarUseCase avatar.AvatarUseCase, cfg *config.Config) *handler.AvatarHandler {
+	return handler.NewAvatarHandler(avatarUseCase, cfg.Storage.AvatarMaxBytes)
+}
+func ProvideJWKSHandler(jwtKeys *infraauth.JWTKeySet) *handler.JWKSHandler {
+	return handler.NewJWKSHandler(jwtKeys)
+}
 
 // Router
 func ProvideRouter(
@@ -134,16 +337,34 @@ func ProvideRouter(
 	boardHandler *handler.BoardHandler,
 	columnHandler *handler.ColumnHandler,
 	cardHandler *handler.CardHandler,
+	adminHandler *handler.AdminHandler,
+	accessTokenHandler *handler.AccessTokenHandler,
+	mfaHandler *handler.MFAHandler,
+	jwksHandler *handler.JWKSHandler,
+	sessionHandler *handler.SessionHandler,
+	avatarHandler *handler.AvatarHandler,
+	jwtKeys *infraauth.JWTKeySet,
+	revocationStore common.TokenRevocationStore,
+	accessTokenAuth common.AccessTokenAuthenticator,
 ) *gin.Engine {
 	return router.New(router.Config{
-		Cfg:              cfg,
-		Log:              log,
-		AuthHandler:      authHandler,
-		UserHandler:      userHandler,
-		WorkspaceHandler: workspaceHandler,
-		BoardHandler:     boardHandler,
-		ColumnHandler:    columnHandler,
-		CardHandler:      cardHandler,
+		Cfg:                cfg,
+		Log:                log,
+		JWTKeys:            jwtKeys,
+		RevocationStore:    revocationStore,
+		AccessTokenAuth:    accessTokenAuth,
+		AuthHandler:        authHandler,
+		UserHandler:        userHandler,
+		WorkspaceHandler:   workspaceHandler,
+		BoardHandler:       boardHandler,
+		ColumnHandler:      columnHandler,
+		CardHandler:        cardHandler,
+		AdminHandler:       adminHandler,
+		AccessTokenHandler: accessTokenHandler,
+		MFAHandler:         mfaHandler,
+		JWKSHandler:        jwksHandler,
+		SessionHandler:     sessionHandler,
+		AvatarHandler:      avatarHandler,
 	})
 }
 
@@ -23,6 +23,11 @@ type App struct {
 var (
 	ConfigSet     = wire.NewSet(ProvideConfig)
 	LoggerSet     = wire.NewSet(ProvideLogger)
+	MailerSet     = wire.NewSet(ProvideMailer)
+	OIDCSet       = wire.NewSet(ProvideOIDCProvider)
+	StorageSet    = wire.NewSet(ProvideBlobStore)
+	DomainSet     = wire.NewSet(ProvideDomainVerifier)
+	JWTKeySet     = wire.NewSet(ProvideJWTKeySet)
 	DBSet         = wire.NewSet(ProvideDB, ProvideCleanup)
 	RepositorySet = wire.NewSet(
 		ProvideUserRepository,
@@ -32,14 +37,37 @@ var (
 		ProvideBoardMemberRepository,
 		ProvideColumnRepository,
 		ProvideCardRepository,
+		ProvideRefreshTokenRepository,
+		ProvideRevokedTokenRepository,
+		ProvidePasswordResetTokenRepository,
+		ProvideEmailVerificationTokenRepository,
+		ProvidePersonalAccessTokenRepository,
+		ProvideUserMFARepository,
+		ProvideMFAChallengeRepository,
+		ProvideUserIdentityRepository,
+		ProvideOIDCLoginStateRepository,
+		ProvideSessionRepository,
+		ProvideWorkspaceInvitationRepository,
+		ProvideWorkspaceJoinLinkRepository,
+		ProvideWorkspaceEmailDomainRepository,
+		ProvideWorkspaceGroupRepository,
 	)
 	UseCaseSet = wire.NewSet(
 		ProvideAuthUseCase,
 		ProvideWorkspaceUseCase,
 		ProvideBoardUseCase,
 		ProvideBoardAccessChecker,
+		ProvideTokenRevocationStore,
 		ProvideColumnUseCase,
 		ProvideCardUseCase,
+		ProvideAdminUseCase,
+		ProvideAccessTokenUseCase,
+		ProvideSessionUseCase,
+		ProvideAvatarUseCase,
+		ProvideAccessTokenAuthenticator,
+		ProvideLoginThrottler,
+		ProvideWorkspaceInvitationAcceptor,
+		ProvideWorkspaceDomainJoiner,
 	)
 	HandlerSet = wire.NewSet(
 		ProvideAuthHandler,
@@ -48,6 +76,12 @@ var (
 		ProvideBoardHandler,
 		ProvideColumnHandler,
 		ProvideCardHandler,
+		ProvideAdminHandler,
+		ProvideAccessTokenHandler,
+		ProvideMFAHandler,
+		ProvideJWKSHandler,
+		ProvideSessionHandler,
+		ProvideAvatarHandler,
 	)
 	RouterSet = wire.NewSet(ProvideRouter)
 	ServerSet = wire.NewSet(ProvideServer)
@@ -58,6 +92,11 @@ func InitializeApp() (*App, error) {
 		ConfigSet,
 		LoggerSet,
 		DBSet,
+		MailerSet,
+		JWTKeySet,
+		OIDCSet,
+		StorageSet,
+		DomainSet,
 		RepositorySet,
 		UseCaseSet,
 		HandlerSet,
@@ -27,25 +27,68 @@ func InitializeApp() (*App, error) {
 		return nil, err
 	}
 	userRepository := ProvideUserRepository(db)
-	authUseCase := ProvideAuthUseCase(userRepository, config)
+	refreshTokenRepository := ProvideRefreshTokenRepository(db)
+	passwordResetTokenRepository := ProvidePasswordResetTokenRepository(db)
+	emailVerificationTokenRepository := ProvideEmailVerificationTokenRepository(db)
+	userMFARepository := ProvideUserMFARepository(db)
+	mfaChallengeRepository := ProvideMFAChallengeRepository(db)
+	userIdentityRepository := ProvideUserIdentityRepository(db)
+	oidcLoginStateRepository := ProvideOIDCLoginStateRepository(db)
+	sessionRepository := ProvideSessionRepository(db)
+	revokedTokenRepository := ProvideRevokedTokenRepository(db)
+	tokenRevocationStore := ProvideTokenRevocationStore(revokedTokenRepository, userRepository, sessionRepository, config)
+	loginThrottler := ProvideLoginThrottler(config, logger)
+	workspaceInvitationRepository := ProvideWorkspaceInvitationRepository(db)
+	workspaceInvitationAcceptor := ProvideWorkspaceInvitationAcceptor(workspaceInvitationRepository)
+	workspaceEmailDomainRepository := ProvideWorkspaceEmailDomainRepository(db)
+	workspaceDomainJoiner := ProvideWorkspaceDomainJoiner(workspaceEmailDomainRepository)
+	mailer, err := ProvideMailer(config, logger)
+	if err != nil {
+		return nil, err
+	}
+	provider := ProvideOIDCProvider(config)
+	jwtKeySet, err := ProvideJWTKeySet(config)
+	if err != nil {
+		return nil, err
+	}
+	authUseCase := ProvideAuthUseCase(userRepository, refreshTokenRepository, passwordResetTokenRepository, emailVerificationTokenRepository, userMFARepository, mfaChallengeRepository, userIdentityRepository, oidcLoginStateRepository, sessionRepository, tokenRevocationStore, loginThrottler, workspaceInvitationAcceptor, workspaceDomainJoiner, mailer, provider, config, jwtKeySet)
 	authHandler := ProvideAuthHandler(authUseCase)
 	userHandler := ProvideUserHandler(authUseCase)
 	workspaceRepository := ProvideWorkspaceRepository(db)
 	workspaceMemberRepository := ProvideWorkspaceMemberRepository(db)
-	workspaceUseCase := ProvideWorkspaceUseCase(workspaceRepository, workspaceMemberRepository, userRepository)
+	workspaceJoinLinkRepository := ProvideWorkspaceJoinLinkRepository(db)
+	workspaceGroupRepository := ProvideWorkspaceGroupRepository(db)
+	verifier := ProvideDomainVerifier()
+	workspaceUseCase := ProvideWorkspaceUseCase(workspaceRepository, workspaceMemberRepository, userRepository, workspaceInvitationRepository, workspaceJoinLinkRepository, workspaceEmailDomainRepository, workspaceGroupRepository, workspaceInvitationAcceptor, mailer, verifier, config)
 	workspaceHandler := ProvideWorkspaceHandler(workspaceUseCase)
 	boardRepository := ProvideBoardRepository(db)
 	boardMemberRepository := ProvideBoardMemberRepository(db)
 	columnRepository := ProvideColumnRepository(db)
 	cardRepository := ProvideCardRepository(db)
-	boardUseCase := ProvideBoardUseCase(boardRepository, boardMemberRepository, workspaceRepository, workspaceMemberRepository, userRepository, columnRepository, cardRepository)
+	boardUseCase := ProvideBoardUseCase(boardRepository, boardMemberRepository, workspaceRepository, workspaceMemberRepository, userRepository, columnRepository, cardRepository, workspaceGroupRepository)
 	boardHandler := ProvideBoardHandler(boardUseCase)
 	boardAccessChecker := ProvideBoardAccessChecker(boardRepository, boardMemberRepository, workspaceMemberRepository)
 	columnUseCase := ProvideColumnUseCase(columnRepository, boardAccessChecker)
 	columnHandler := ProvideColumnHandler(columnUseCase)
 	cardUseCase := ProvideCardUseCase(cardRepository, columnRepository, userRepository, boardAccessChecker)
 	cardHandler := ProvideCardHandler(cardUseCase)
-	engine := ProvideRouter(config, logger, authHandler, userHandler, workspaceHandler, boardHandler, columnHandler, cardHandler)
+	personalAccessTokenRepository := ProvidePersonalAccessTokenRepository(db)
+	adminUseCase := ProvideAdminUseCase(userRepository, workspaceRepository, personalAccessTokenRepository, authUseCase)
+	adminHandler := ProvideAdminHandler(adminUseCase)
+	accessTokenUseCase := ProvideAccessTokenUseCase(personalAccessTokenRepository)
+	accessTokenHandler := ProvideAccessTokenHandler(accessTokenUseCase)
+	mfaHandler := ProvideMFAHandler(authUseCase)
+	jwksHandler := ProvideJWKSHandler(jwtKeySet)
+	sessionUseCase := ProvideSessionUseCase(sessionRepository, refreshTokenRepository, tokenRevocationStore, config)
+	sessionHandler := ProvideSessionHandler(sessionUseCase)
+	blobStore, err := ProvideBlobStore(config)
+	if err != nil {
+		return nil, err
+	}
+	avatarUseCase := ProvideAvatarUseCase(userRepository, blobStore, config)
+	avatarHandler := ProvideAvatarHandler(avatarUseCase, config)
+	accessTokenAuthenticator := ProvideAccessTokenAuthenticator(personalAccessTokenRepository, userRepository)
+	engine := ProvideRouter(config, logger, authHandler, userHandler, workspaceHandler, boardHandler, columnHandler, cardHandler, adminHandler, accessTokenHandler, mfaHandler, jwksHandler, sessionHandler, avatarHandler, jwtKeySet, tokenRevocationStore, accessTokenAuthenticator)
 	server := ProvideServer(config, engine)
 	v := ProvideCleanup(db)
 	app := &App{
@@ -71,6 +114,11 @@ type App struct {
 var (
 	ConfigSet     = wire.NewSet(ProvideConfig)
 	LoggerSet     = wire.NewSet(ProvideLogger)
+	MailerSet     = wire.NewSet(ProvideMailer)
+	OIDCSet       = wire.NewSet(ProvideOIDCProvider)
+	StorageSet    = wire.NewSet(ProvideBlobStore)
+	DomainSet     = wire.NewSet(ProvideDomainVerifier)
+	JWTKeySet     = wire.NewSet(ProvideJWTKeySet)
 	DBSet         = wire.NewSet(ProvideDB, ProvideCleanup)
 	RepositorySet = wire.NewSet(
 		ProvideUserRepository,
@@ -80,14 +128,37 @@ var (
 		ProvideBoardMemberRepository,
 		ProvideColumnRepository,
 		ProvideCardRepository,
+		ProvideRefreshTokenRepository,
+		ProvideRevokedTokenRepository,
+		ProvidePasswordResetTokenRepository,
+		ProvideEmailVerificationTokenRepository,
+		ProvidePersonalAccessTokenRepository,
+		ProvideUserMFARepository,
+		ProvideMFAChallengeRepository,
+		ProvideUserIdentityRepository,
+		ProvideOIDCLoginStateRepository,
+		ProvideSessionRepository,
+		ProvideWorkspaceInvitationRepository,
+		ProvideWorkspaceJoinLinkRepository,
+		ProvideWorkspaceEmailDomainRepository,
+		ProvideWorkspaceGroupRepository,
 	)
 	UseCaseSet = wire.NewSet(
 		ProvideAuthUseCase,
 		ProvideWorkspaceUseCase,
 		ProvideBoardUseCase,
 		ProvideBoardAccessChecker,
+		ProvideTokenRevocationStore,
 		ProvideColumnUseCase,
 		ProvideCardUseCase,
+		ProvideAdminUseCase,
+		ProvideAccessTokenUseCase,
+		ProvideSessionUseCase,
+		ProvideAvatarUseCase,
+		ProvideAccessTokenAuthenticator,
+		ProvideLoginThrottler,
+		ProvideWorkspaceInvitationAcceptor,
+		ProvideWorkspaceDomainJoiner,
 	)
 	HandlerSet = wire.NewSet(
 		ProvideAuthHandler,
@@ -96,6 +167,12 @@ var (
 		ProvideBoardHandler,
 		ProvideColumnHandler,
 		ProvideCardHandler,
+		ProvideAdminHandler,
+		ProvideAccessTokenHandler,
+		ProvideMFAHandler,
+		ProvideJWKSHandler,
+		ProvideSessionHandler,
+		ProvideAvatarHandler,
 	)
 	RouterSet = wire.NewSet(ProvideRouter)
 	ServerSet = wire.NewSet(ProvideServer)
@@ -0,0 +1,15 @@
+package accesstoken
+
+import (
+	"collabotask/internal/domain/repository"
+)
+
+type AccessTokenUseCaseImpl struct {
+	accessTokenRepo repository.PersonalAccessTokenRepository
+}
+
+func NewAccessTokenUseCase(accessTokenRepo repository.PersonalAccessTokenRepository) AccessTokenUseCase {
+	return &AccessTokenUseCaseImpl{
+		accessTokenRepo: accessTokenRepo,
+	}
+}
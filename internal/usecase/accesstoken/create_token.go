@@ -0,0 +1,45 @@
+package accesstoken
+
+import (
+	"collabotask/internal/domain/entity"
+	"collabotask/internal/dto"
+	infraauth "collabotask/internal/infrastructure/auth"
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+func (au *AccessTokenUseCaseImpl) CreateToken(ctx context.Context, input CreateTokenInput) (*CreateTokenOutput, error) {
+	input.Name = strings.TrimSpace(input.Name)
+	if err := validator.Struct(input); err != nil {
+		return nil, fmt.Errorf("failed to validate create token input: %w", err)
+	}
+
+	rawToken, prefix, hash, err := infraauth.GeneratePersonalAccessToken()
+	if err != nil {
+		return nil, err
+	}
+
+	token := &entity.PersonalAccessToken{
+		UserID:      input.UserID,
+		Name:        input.Name,
+		TokenPrefix: prefix,
+		TokenHash:   hash,
+		Scope:       entity.AccessTokenScope(input.Scope),
+	}
+	if input.ExpiresInDays != nil {
+		expiresAt := time.Now().UTC().AddDate(0, 0, *input.ExpiresInDays)
+		token.ExpiresAt = &expiresAt
+	}
+
+	if err := au.accessTokenRepo.Create(ctx, token); err != nil {
+		return nil, err
+	}
+
+	return &CreateTokenOutput{
+		AccessToken: dto.AccessTokenToDTO(token),
+		Token:       rawToken,
+	}, nil
+}
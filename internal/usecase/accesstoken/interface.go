@@ -0,0 +1,39 @@
+package accesstoken
+
+import (
+	"collabotask/internal/dto"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type AccessTokenUseCase interface {
+	CreateToken(ctx context.Context, input CreateTokenInput) (*CreateTokenOutput, error)
+	ListTokens(ctx context.Context, input ListTokensInput) (*ListTokensOutput, error)
+	RevokeToken(ctx context.Context, input RevokeTokenInput) error
+}
+
+type CreateTokenInput struct {
+	UserID        uuid.UUID `validate:"required"`
+	Name          string    `validate:"required,min=1,max=100"`
+	Scope         string    `validate:"required,oneof=read-only read-write"`
+	ExpiresInDays *int      `validate:"omitempty,min=1,max=365"`
+}
+
+type CreateTokenOutput struct {
+	AccessToken dto.AccessTokenDTO
+	Token       string
+}
+
+type ListTokensInput struct {
+	UserID uuid.UUID `validate:"required"`
+}
+
+type ListTokensOutput struct {
+	AccessTokens []dto.AccessTokenDTO
+}
+
+type RevokeTokenInput struct {
+	UserID  uuid.UUID `validate:"required"`
+	TokenID uuid.UUID `validate:"required"`
+}
@@ -0,0 +1,26 @@
+package accesstoken
+
+import (
+	"collabotask/internal/dto"
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"fmt"
+)
+
+func (au *AccessTokenUseCaseImpl) ListTokens(ctx context.Context, input ListTokensInput) (*ListTokensOutput, error) {
+	if err := validator.Struct(input); err != nil {
+		return nil, fmt.Errorf("failed to validate list tokens input: %w", err)
+	}
+
+	tokens, err := au.accessTokenRepo.ListByUser(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]dto.AccessTokenDTO, 0, len(tokens))
+	for _, token := range tokens {
+		result = append(result, dto.AccessTokenToDTO(token))
+	}
+
+	return &ListTokensOutput{AccessTokens: result}, nil
+}
@@ -0,0 +1,24 @@
+package accesstoken
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"errors"
+	"fmt"
+)
+
+func (au *AccessTokenUseCaseImpl) RevokeToken(ctx context.Context, input RevokeTokenInput) error {
+	if err := validator.Struct(input); err != nil {
+		return fmt.Errorf("failed to validate revoke token input: %w", err)
+	}
+
+	if err := au.accessTokenRepo.Revoke(ctx, input.TokenID, input.UserID); err != nil {
+		if errors.Is(err, domain.ErrAccessTokenNotFound) {
+			return domain.ErrAccessTokenNotFound
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package admin
+
+import (
+	"collabotask/internal/domain/repository"
+	"collabotask/internal/usecase/auth"
+)
+
+const defaultPageLimit = 20
+
+type AdminUseCaseImpl struct {
+	userRepo        repository.UserRepository
+	workspaceRepo   repository.WorkspaceRepository
+	accessTokenRepo repository.PersonalAccessTokenRepository
+	authUseCase     auth.AuthUseCase
+}
+
+func NewAdminUseCase(
+	userRepo repository.UserRepository,
+	workspaceRepo repository.WorkspaceRepository,
+	accessTokenRepo repository.PersonalAccessTokenRepository,
+	authUseCase auth.AuthUseCase,
+) AdminUseCase {
+	return &AdminUseCaseImpl{
+		userRepo:        userRepo,
+		workspaceRepo:   workspaceRepo,
+		accessTokenRepo: accessTokenRepo,
+		authUseCase:     authUseCase,
+	}
+}
@@ -0,0 +1,41 @@
+package admin
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/infrastructure/validator"
+	"collabotask/internal/usecase/auth"
+	"context"
+	"errors"
+	"fmt"
+)
+
+func (au *AdminUseCaseImpl) ForcePasswordReset(ctx context.Context, input ForcePasswordResetInput) error {
+	if err := validator.Struct(input); err != nil {
+		return fmt.Errorf("failed to validate force password reset input: %w", err)
+	}
+
+	user, err := au.userRepo.GetById(ctx, input.UserID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return domain.ErrUserNotFound
+		}
+		return fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	// The flag blocks login until the user picks a new password, so the
+	// existing sessions and access tokens are dropped and a reset link is
+	// mailed right away.
+	if err := au.userRepo.SetPasswordResetRequired(ctx, user.ID, true); err != nil {
+		return fmt.Errorf("failed to require password reset: %w", err)
+	}
+
+	if err := au.authUseCase.LogoutAll(ctx, auth.LogoutAllInput{UserID: user.ID}); err != nil {
+		return err
+	}
+
+	if err := au.accessTokenRepo.RevokeAllByUser(ctx, user.ID); err != nil {
+		return err
+	}
+
+	return au.authUseCase.ForgotPassword(ctx, auth.ForgotPasswordInput{Email: user.Email})
+}
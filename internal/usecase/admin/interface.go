@@ -0,0 +1,51 @@
+package admin
+
+import (
+	"collabotask/internal/dto"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type AdminUseCase interface {
+	ListUsers(ctx context.Context, input ListUsersInput) (*ListUsersOutput, error)
+	SetUserSuspended(ctx context.Context, input SetUserSuspendedInput) (*dto.AdminUserDTO, error)
+	ForcePasswordReset(ctx context.Context, input ForcePasswordResetInput) error
+	ListWorkspaces(ctx context.Context, input ListWorkspacesInput) (*ListWorkspacesOutput, error)
+}
+
+type ListUsersInput struct {
+	Search string `validate:"max=255"`
+	Limit  int    `validate:"min=0,max=100"`
+	Offset int    `validate:"min=0"`
+}
+
+type ListUsersOutput struct {
+	Users  []dto.AdminUserDTO
+	Total  int
+	Limit  int
+	Offset int
+}
+
+type SetUserSuspendedInput struct {
+	RequesterID uuid.UUID `validate:"required"`
+	UserID      uuid.UUID `validate:"required"`
+	Suspended   bool
+}
+
+type ForcePasswordResetInput struct {
+	UserID uuid.UUID `validate:"required"`
+}
+
+type ListWorkspacesInput struct {
+	Search string `validate:"max=255"`
+	Limit  int    `validate:"min=0,max=100"`
+	Offset int    `validate:"min=0"`
+}
+
+type ListWorkspacesOutput struct {
+	Workspaces []dto.WorkspaceSummaryDTO
+	Total      int
+	Limit      int
+	Offset     int
+}
@@ -0,0 +1,39 @@
+package admin
+
+import (
+	"collabotask/internal/dto"
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"fmt"
+)
+
+func (au *AdminUseCaseImpl) ListUsers(ctx context.Context, input ListUsersInput) (*ListUsersOutput, error) {
+	if err := validator.Struct(input); err != nil {
+		return nil, fmt.Errorf("failed to validate list users input: %w", err)
+	}
+	if input.Limit == 0 {
+		input.Limit = defaultPageLimit
+	}
+
+	users, err := au.userRepo.List(ctx, input.Search, input.Limit, input.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	total, err := au.userRepo.Count(ctx, input.Search)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count users: %w", err)
+	}
+
+	result := make([]dto.AdminUserDTO, 0, len(users))
+	for _, user := range users {
+		result = append(result, dto.UserToAdminDTO(user))
+	}
+
+	return &ListUsersOutput{
+		Users:  result,
+		Total:  total,
+		Limit:  input.Limit,
+		Offset: input.Offset,
+	}, nil
+}
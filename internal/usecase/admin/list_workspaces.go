@@ -0,0 +1,39 @@
+package admin
+
+import (
+	"collabotask/internal/dto"
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"fmt"
+)
+
+func (au *AdminUseCaseImpl) ListWorkspaces(ctx context.Context, input ListWorkspacesInput) (*ListWorkspacesOutput, error) {
+	if err := validator.Struct(input); err != nil {
+		return nil, fmt.Errorf("failed to validate list workspaces input: %w", err)
+	}
+	if input.Limit == 0 {
+		input.Limit = defaultPageLimit
+	}
+
+	workspaces, err := au.workspaceRepo.List(ctx, input.Search, input.Limit, input.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workspaces: %w", err)
+	}
+
+	total, err := au.workspaceRepo.Count(ctx, input.Search)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count workspaces: %w", err)
+	}
+
+	result := make([]dto.WorkspaceSummaryDTO, 0, len(workspaces))
+	for _, workspace := range workspaces {
+		result = append(result, dto.WorkspaceSummaryToDTO(workspace))
+	}
+
+	return &ListWorkspacesOutput{
+		Workspaces: result,
+		Total:      total,
+		Limit:      input.Limit,
+		Offset:     input.Offset,
+	}, nil
+}
@@ -0,0 +1,50 @@
+package admin
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/dto"
+	"collabotask/internal/infrastructure/validator"
+	"collabotask/internal/usecase/auth"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+func (au *AdminUseCaseImpl) SetUserSuspended(ctx context.Context, input SetUserSuspendedInput) (*dto.AdminUserDTO, error) {
+	if err := validator.Struct(input); err != nil {
+		return nil, fmt.Errorf("failed to validate suspend user input: %w", err)
+	}
+
+	if input.Suspended && input.RequesterID == input.UserID {
+		return nil, domain.ErrCannotSuspendYourself
+	}
+
+	var suspendedAt *time.Time
+	if input.Suspended {
+		now := time.Now()
+		suspendedAt = &now
+	}
+
+	if err := au.userRepo.SetSuspended(ctx, input.UserID, suspendedAt); err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to update suspension: %w", err)
+	}
+
+	if input.Suspended {
+		if err := au.authUseCase.LogoutAll(ctx, auth.LogoutAllInput{UserID: input.UserID}); err != nil {
+			return nil, err
+		}
+	}
+
+	user, err := au.userRepo.GetById(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := dto.UserToAdminDTO(user)
+
+	return &result, nil
+}
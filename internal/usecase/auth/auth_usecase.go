@@ -5,22 +5,80 @@ import (
 
 	"collabotask/internal/config"
 	"collabotask/internal/domain/repository"
+	infraauth "collabotask/internal/infrastructure/auth"
+	"collabotask/internal/infrastructure/mailer"
+	"collabotask/internal/infrastructure/oidc"
+	"collabotask/internal/usecase/common"
 )
 
 const (
 	minPasswordLen = 8
+
+	mfaRecoveryCodeCount    = 10
+	maxMFAChallengeAttempts = 5
 )
 
 var emailRegex = regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
 
 type AuthUseCaseImpl struct {
-	userRepo repository.UserRepository
-	authCfg  *config.AuthConfig
+	userRepo              repository.UserRepository
+	refreshTokenRepo      repository.RefreshTokenRepository
+	passwordResetRepo     repository.PasswordResetTokenRepository
+	emailVerificationRepo repository.EmailVerificationTokenRepository
+	userMFARepo           repository.UserMFARepository
+	mfaChallengeRepo      repository.MFAChallengeRepository
+	userIdentityRepo      repository.UserIdentityRepository
+	oidcStateRepo         repository.OIDCLoginStateRepository
+	sessionRepo           repository.SessionRepository
+	revocationStore       common.TokenRevocationStore
+	loginThrottler        common.LoginThrottler
+	invitationAcceptor    common.WorkspaceInvitationAcceptor
+	domainJoiner          common.WorkspaceDomainJoiner
+	mailer                mailer.Mailer
+	oidcProvider          oidc.Provider
+	authCfg               *config.AuthConfig
+	oidcCfg               *config.OIDCConfig
+	jwtKeys               *infraauth.JWTKeySet
 }
 
-func NewAuthUseCase(userRepo repository.UserRepository, authCfg *config.AuthConfig) AuthUseCase {
+func NewAuthUseCase(
+	userRepo repository.UserRepository,
+	refreshTokenRepo repository.RefreshTokenRepository,
+	passwordResetRepo repository.PasswordResetTokenRepository,
+	emailVerificationRepo repository.EmailVerificationTokenRepository,
+	userMFARepo repository.UserMFARepository,
+	mfaChallengeRepo repository.MFAChallengeRepository,
+	userIdentityRepo repository.UserIdentityRepository,
+	oidcStateRepo repository.OIDCLoginStateRepository,
+	sessionRepo repository.SessionRepository,
+	revocationStore common.TokenRevocationStore,
+	loginThrottler common.LoginThrottler,
+	invitationAcceptor common.WorkspaceInvitationAcceptor,
+	domainJoiner common.WorkspaceDomainJoiner,
+	mailer mailer.Mailer,
+	oidcProvider oidc.Provider,
+	authCfg *config.AuthConfig,
+	oidcCfg *config.OIDCConfig,
+	jwtKeys *infraauth.JWTKeySet,
+) AuthUseCase {
 	return &AuthUseCaseImpl{
-		userRepo: userRepo,
-		authCfg:  authCfg,
+		userRepo:              userRepo,
+		refreshTokenRepo:      refreshTokenRepo,
+		passwordResetRepo:     passwordResetRepo,
+		emailVerificationRepo: emailVerificationRepo,
+		userMFARepo:           userMFARepo,
+		mfaChallengeRepo:      mfaChallengeRepo,
+		userIdentityRepo:      userIdentityRepo,
+		oidcStateRepo:         oidcStateRepo,
+		sessionRepo:           sessionRepo,
+		revocationStore:       revocationStore,
+		loginThrottler:        loginThrottler,
+		invitationAcceptor:    invitationAcceptor,
+		domainJoiner:          domainJoiner,
+		mailer:                mailer,
+		oidcProvider:          oidcProvider,
+		authCfg:               authCfg,
+		oidcCfg:               oidcCfg,
+		jwtKeys:               jwtKeys,
 	}
 }
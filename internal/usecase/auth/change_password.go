@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/domain/entity"
+	infraauth "collabotask/internal/infrastructure/auth"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+func (u *AuthUseCaseImpl) ChangePassword(ctx context.Context, input ChangePasswordInput) (*ChangePasswordOutput, error) {
+	if err := ValidatePassword(input.NewPassword); err != nil {
+		return nil, err
+	}
+
+	user, err := u.userRepo.GetById(ctx, input.UserID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+	if user == nil {
+		return nil, domain.ErrUserNotFound
+	}
+
+	// Accounts without a password set one through the reset flow instead.
+	if !user.HasPassword() {
+		return nil, domain.ErrPasswordNotSet
+	}
+	if !infraauth.CheckPassword(input.CurrentPassword, user.PasswordHash) {
+		return nil, domain.ErrIncorrectPassword
+	}
+
+	hash, err := infraauth.HashPassword(u.authCfg, input.NewPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.userRepo.Update(ctx, &entity.User{
+		ID:           user.ID,
+		PasswordHash: hash,
+		UpdatedAt:    time.Now(),
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to update password: %w", err)
+	}
+
+	// Every session, the caller's included, is signed out; the caller is
+	// handed a fresh token pair so only the other sessions are lost.
+	if err := u.LogoutAll(ctx, LogoutAllInput{UserID: user.ID}); err != nil {
+		return nil, err
+	}
+
+	token, refreshToken, err := u.issueTokens(ctx, user, input.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ChangePasswordOutput{
+		Token:        token,
+		RefreshToken: refreshToken,
+	}, nil
+}
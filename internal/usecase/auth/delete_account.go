@@ -0,0 +1,48 @@
+package auth
+
+import (
+	"collabotask/internal/domain"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+func (u *AuthUseCaseImpl) DeleteAccount(ctx context.Context, input DeleteAccountInput) (*DeleteAccountOutput, error) {
+	user, err := u.userRepo.GetById(ctx, input.UserID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+	if user == nil {
+		return nil, domain.ErrUserNotFound
+	}
+
+	if err := u.reauthenticate(ctx, user, input.Password, input.Code); err != nil {
+		return nil, err
+	}
+
+	summary, err := u.userRepo.DeleteAccount(ctx, user.ID, time.Now())
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to delete account: %w", err)
+	}
+
+	// The repository already moved tokens_valid_after; going through the
+	// store as well refreshes its cache so outstanding tokens stop working
+	// immediately.
+	if err := u.revocationStore.RevokeAllForUser(ctx, user.ID); err != nil {
+		return nil, fmt.Errorf("failed to revoke access tokens: %w", err)
+	}
+
+	return &DeleteAccountOutput{
+		TransferredWorkspaces: summary.TransferredWorkspaces,
+		DeletedWorkspaces:     summary.DeletedWorkspaces,
+		TransferredBoards:     summary.TransferredBoards,
+		ReassignedCards:       summary.ReassignedCards,
+	}, nil
+}
@@ -3,6 +3,7 @@ package auth
 import (
 	"collabotask/internal/dto"
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -10,26 +11,184 @@ import (
 type AuthUseCase interface {
 	Register(ctx context.Context, input RegisterInput) (*RegisterOutput, error)
 	Login(ctx context.Context, input LoginInput) (*LoginOutput, error)
+	RefreshToken(ctx context.Context, input RefreshTokenInput) (*RefreshTokenOutput, error)
+	Logout(ctx context.Context, input LogoutInput) error
+	LogoutAll(ctx context.Context, input LogoutAllInput) error
+	ForgotPassword(ctx context.Context, input ForgotPasswordInput) error
+	ResetPassword(ctx context.Context, input ResetPasswordInput) error
+	VerifyEmail(ctx context.Context, input VerifyEmailInput) error
+	ResendVerificationEmail(ctx context.Context, input ResendVerificationEmailInput) error
 	GetProfile(ctx context.Context, userID uuid.UUID) (*dto.UserDTO, error)
+	UpdateProfile(ctx context.Context, input UpdateProfileInput) (*dto.UserDTO, error)
+	ChangePassword(ctx context.Context, input ChangePasswordInput) (*ChangePasswordOutput, error)
+	DeleteAccount(ctx context.Context, input DeleteAccountInput) (*DeleteAccountOutput, error)
+	GetMFAStatus(ctx context.Context, userID uuid.UUID) (*MFAStatusOutput, error)
+	EnrollTOTP(ctx context.Context, userID uuid.UUID) (*EnrollTOTPOutput, error)
+	ConfirmTOTP(ctx context.Context, input ConfirmTOTPInput) (*RecoveryCodesOutput, error)
+	DisableTOTP(ctx context.Context, input DisableTOTPInput) error
+	RegenerateRecoveryCodes(ctx context.Context, input RegenerateRecoveryCodesInput) (*RecoveryCodesOutput, error)
+	VerifyMFA(ctx context.Context, input VerifyMFAInput) (*LoginOutput, error)
+	StartOIDCLogin(ctx context.Context) (*StartOIDCLoginOutput, error)
+	CompleteOIDCLogin(ctx context.Context, input CompleteOIDCLoginInput) (*LoginOutput, error)
+}
+
+// ClientInfo describes the device a session is started from.
+type ClientInfo struct {
+	IPAddress string
+	UserAgent string
 }
 
 type RegisterInput struct {
 	Email    string
 	Name     string
 	Password string
+	// InvitationToken optionally carries a workspace invitation sent to
+	// Email. It verifies the address and joins the invited workspaces.
+	InvitationToken string
+	Client          ClientInfo
 }
 
 type RegisterOutput struct {
-	User  dto.UserDTO
-	Token string
+	User         dto.UserDTO
+	Token        string
+	RefreshToken string
 }
 
 type LoginInput struct {
 	Email    string
 	Password string
+	Client   ClientInfo
 }
 
 type LoginOutput struct {
-	User  dto.UserDTO
+	User         dto.UserDTO
+	Token        string
+	RefreshToken string
+	// MFARequired is set instead of the user and token pair when the
+	// account has two-factor authentication enabled; MFAToken must then be
+	// exchanged through VerifyMFA.
+	MFARequired bool
+	MFAToken    string
+}
+
+type RefreshTokenInput struct {
+	RefreshToken string
+	Client       ClientInfo
+}
+
+type RefreshTokenOutput struct {
+	Token        string
+	RefreshToken string
+}
+
+type LogoutInput struct {
+	UserID       uuid.UUID
+	TokenID      string
+	SessionID    uuid.UUID
+	ExpiresAt    time.Time
+	RefreshToken string
+}
+
+type LogoutAllInput struct {
+	UserID uuid.UUID
+}
+
+type ForgotPasswordInput struct {
+	Email string
+}
+
+type ResetPasswordInput struct {
+	Token       string
+	NewPassword string
+}
+
+type VerifyEmailInput struct {
 	Token string
 }
+
+type ResendVerificationEmailInput struct {
+	Email string
+}
+
+type UpdateProfileInput struct {
+	UserID           uuid.UUID `validate:"required"`
+	Name             *string   `validate:"omitempty,min=1,max=255"`
+	AvatarURL        *string   `validate:"omitempty,max=500,http_url"`
+	AvatarURLPresent bool
+}
+
+type ChangePasswordInput struct {
+	UserID          uuid.UUID
+	CurrentPassword string
+	NewPassword     string
+	Client          ClientInfo
+}
+
+type ChangePasswordOutput struct {
+	Token        string
+	RefreshToken string
+}
+
+// Code is a TOTP or recovery code, accepted instead of the password for
+// accounts that have none.
+type DeleteAccountInput struct {
+	UserID   uuid.UUID
+	Password string
+	Code     string
+}
+
+type DeleteAccountOutput struct {
+	TransferredWorkspaces int
+	DeletedWorkspaces     int
+	TransferredBoards     int
+	ReassignedCards       int
+}
+
+type MFAStatusOutput struct {
+	Enabled                bool
+	RecoveryCodesRemaining int
+}
+
+type EnrollTOTPOutput struct {
+	Secret string
+	URI    string
+}
+
+type ConfirmTOTPInput struct {
+	UserID uuid.UUID
+	Code   string
+}
+
+type DisableTOTPInput struct {
+	UserID   uuid.UUID
+	Password string
+	Code     string
+}
+
+type RegenerateRecoveryCodesInput struct {
+	UserID   uuid.UUID
+	Password string
+	Code     string
+}
+
+type RecoveryCodesOutput struct {
+	RecoveryCodes []string
+}
+
+type VerifyMFAInput struct {
+	MFAToken string
+	Code     string
+	Client   ClientInfo
+}
+
+type StartOIDCLoginOutput struct {
+	AuthURL   string
+	State     string
+	ExpiresAt time.Time
+}
+
+type CompleteOIDCLoginInput struct {
+	State  string
+	Code   string
+	Client ClientInfo
+}
@@ -5,7 +5,6 @@ import (
 	"collabotask/internal/dto"
 	infraauth "collabotask/internal/infrastructure/auth"
 	"context"
-	"fmt"
 	"strings"
 )
 
@@ -14,22 +13,62 @@ func (u *AuthUseCaseImpl) Login(ctx context.Context, input LoginInput) (*LoginOu
 		return nil, err
 	}
 
-	user, err := u.userRepo.GetByEmail(ctx, strings.TrimSpace(strings.ToLower(input.Email)))
+	email := strings.TrimSpace(strings.ToLower(input.Email))
+
+	// Checked before touching bcrypt so throttled clients stay cheap to turn
+	// away.
+	if wait := u.loginThrottler.RetryAfter(email, input.Client.IPAddress); wait > 0 {
+		return nil, &domain.LoginThrottledError{RetryAfter: wait}
+	}
+
+	user, err := u.userRepo.GetByEmail(ctx, email)
 	if err != nil {
+		u.loginThrottler.RecordFailure(email, input.Client.IPAddress)
 		return nil, domain.ErrInvalidCredentials
 	}
 
 	if !infraauth.CheckPassword(input.Password, user.PasswordHash) {
+		u.loginThrottler.RecordFailure(email, input.Client.IPAddress)
 		return nil, domain.ErrInvalidCredentials
 	}
 
-	token, err := infraauth.GenerateToken(u.authCfg, user.ID, string(user.SystemRole))
+	u.loginThrottler.RecordSuccess(email, input.Client.IPAddress)
+
+	if user.IsSuspended() {
+		return nil, domain.ErrAccountSuspended
+	}
+
+	if user.PasswordResetRequired {
+		return nil, domain.ErrPasswordResetRequired
+	}
+
+	if u.authCfg.RequireVerifiedEmailForLogin && !user.IsEmailVerified() {
+		return nil, domain.ErrEmailNotVerified
+	}
+
+	mfaToken, err := u.startMFAChallenge(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if mfaToken != "" {
+		return &LoginOutput{
+			MFARequired: true,
+			MFAToken:    mfaToken,
+		}, nil
+	}
+
+	// Domains may have been verified since the user registered. Joining is
+	// best effort and never blocks a login.
+	_, _ = u.domainJoiner.JoinByEmailDomain(ctx, user)
+
+	token, refreshToken, err := u.issueTokens(ctx, user, input.Client)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate token: %w", err)
+		return nil, err
 	}
 
 	return &LoginOutput{
-		User:  dto.UserToDTO(user),
-		Token: token,
+		User:         dto.UserToDTO(user),
+		Token:        token,
+		RefreshToken: refreshToken,
 	}, nil
 }
@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"collabotask/internal/domain"
+	infraauth "collabotask/internal/infrastructure/auth"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+func (u *AuthUseCaseImpl) Logout(ctx context.Context, input LogoutInput) error {
+	if input.TokenID != "" {
+		if err := u.revocationStore.Revoke(ctx, input.TokenID, input.UserID, input.ExpiresAt); err != nil {
+			return fmt.Errorf("failed to revoke access token: %w", err)
+		}
+	}
+
+	if input.SessionID != uuid.Nil {
+		if err := u.revocationStore.RevokeSession(ctx, input.SessionID, input.UserID); err != nil && !errors.Is(err, domain.ErrSessionNotFound) {
+			return fmt.Errorf("failed to revoke session: %w", err)
+		}
+		if err := u.refreshTokenRepo.RevokeFamily(ctx, input.SessionID); err != nil {
+			return fmt.Errorf("failed to revoke refresh token: %w", err)
+		}
+	}
+
+	if input.RefreshToken == "" {
+		return nil
+	}
+
+	refreshToken, err := u.refreshTokenRepo.GetByTokenHash(ctx, infraauth.HashOpaqueToken(input.RefreshToken))
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidRefreshToken) {
+			return nil
+		}
+		return fmt.Errorf("failed to fetch refresh token: %w", err)
+	}
+	if refreshToken.UserID != input.UserID {
+		return nil
+	}
+
+	if err := u.refreshTokenRepo.RevokeFamily(ctx, refreshToken.FamilyID); err != nil {
+		return fmt.Errorf("failed to revoke refresh token: %w", err)
+	}
+
+	return nil
+}
+
+func (u *AuthUseCaseImpl) LogoutAll(ctx context.Context, input LogoutAllInput) error {
+	if err := u.revocationStore.RevokeAllForUser(ctx, input.UserID); err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return domain.ErrUserNotFound
+		}
+		return fmt.Errorf("failed to revoke access tokens: %w", err)
+	}
+
+	if err := u.refreshTokenRepo.RevokeAllByUser(ctx, input.UserID); err != nil {
+		return fmt.Errorf("failed to revoke refresh tokens: %w", err)
+	}
+
+	if err := u.sessionRepo.RevokeAllByUser(ctx, input.UserID); err != nil {
+		return fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	return nil
+}
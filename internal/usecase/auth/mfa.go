@@ -0,0 +1,310 @@
+package auth
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/domain/entity"
+	"collabotask/internal/dto"
+	infraauth "collabotask/internal/infrastructure/auth"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func (u *AuthUseCaseImpl) GetMFAStatus(ctx context.Context, userID uuid.UUID) (*MFAStatusOutput, error) {
+	mfa, err := u.userMFARepo.GetByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrMFANotEnabled) {
+			return &MFAStatusOutput{}, nil
+		}
+		return nil, err
+	}
+	if !mfa.IsConfirmed() {
+		return &MFAStatusOutput{}, nil
+	}
+
+	remaining, err := u.userMFARepo.CountUnusedRecoveryCodes(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MFAStatusOutput{
+		Enabled:                true,
+		RecoveryCodesRemaining: remaining,
+	}, nil
+}
+
+func (u *AuthUseCaseImpl) EnrollTOTP(ctx context.Context, userID uuid.UUID) (*EnrollTOTPOutput, error) {
+	user, err := u.userRepo.GetById(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	secret, err := infraauth.GenerateTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	sealed, err := infraauth.SealSecret(u.authCfg.MFAEncryptionKey, secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt totp secret: %w", err)
+	}
+
+	if err := u.userMFARepo.UpsertPending(ctx, &entity.UserMFA{UserID: user.ID, Secret: sealed}); err != nil {
+		return nil, err
+	}
+
+	return &EnrollTOTPOutput{
+		Secret: secret,
+		URI:    infraauth.TOTPURI(u.authCfg.MFAIssuer, user.Email, secret),
+	}, nil
+}
+
+func (u *AuthUseCaseImpl) ConfirmTOTP(ctx context.Context, input ConfirmTOTPInput) (*RecoveryCodesOutput, error) {
+	mfa, err := u.userMFARepo.GetByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if mfa.IsConfirmed() {
+		return nil, domain.ErrMFAAlreadyEnabled
+	}
+
+	counter, err := u.checkTOTPCode(mfa, input.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.userMFARepo.Confirm(ctx, input.UserID, counter, time.Now().UTC(), hashes); err != nil {
+		return nil, err
+	}
+
+	return &RecoveryCodesOutput{RecoveryCodes: codes}, nil
+}
+
+func (u *AuthUseCaseImpl) DisableTOTP(ctx context.Context, input DisableTOTPInput) error {
+	if err := u.checkUserPassword(ctx, input.UserID, input.Password, input.Code); err != nil {
+		return err
+	}
+
+	return u.userMFARepo.Delete(ctx, input.UserID)
+}
+
+func (u *AuthUseCaseImpl) RegenerateRecoveryCodes(ctx context.Context, input RegenerateRecoveryCodesInput) (*RecoveryCodesOutput, error) {
+	if err := u.checkUserPassword(ctx, input.UserID, input.Password, input.Code); err != nil {
+		return nil, err
+	}
+
+	mfa, err := u.userMFARepo.GetByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !mfa.IsConfirmed() {
+		return nil, domain.ErrMFANotEnabled
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.userMFARepo.ReplaceRecoveryCodes(ctx, input.UserID, hashes); err != nil {
+		return nil, err
+	}
+
+	return &RecoveryCodesOutput{RecoveryCodes: codes}, nil
+}
+
+func (u *AuthUseCaseImpl) VerifyMFA(ctx context.Context, input VerifyMFAInput) (*LoginOutput, error) {
+	if input.MFAToken == "" || strings.TrimSpace(input.Code) == "" {
+		return nil, domain.ErrInvalidMFAChallenge
+	}
+
+	challenge, err := u.mfaChallengeRepo.GetByTokenHash(ctx, infraauth.HashOpaqueToken(input.MFAToken))
+	if err != nil {
+		return nil, err
+	}
+	if challenge.IsUsed() || challenge.IsExpired(time.Now().UTC()) || challenge.Attempts >= maxMFAChallengeAttempts {
+		return nil, domain.ErrInvalidMFAChallenge
+	}
+
+	mfa, err := u.userMFARepo.GetByUserID(ctx, challenge.UserID)
+	if err != nil {
+		if errors.Is(err, domain.ErrMFANotEnabled) {
+			return nil, domain.ErrInvalidMFAChallenge
+		}
+		return nil, err
+	}
+	if !mfa.IsConfirmed() {
+		return nil, domain.ErrInvalidMFAChallenge
+	}
+
+	if err := u.checkSecondFactor(ctx, mfa, input.Code); err != nil {
+		if errors.Is(err, domain.ErrInvalidMFACode) {
+			if err := u.mfaChallengeRepo.RegisterFailedAttempt(ctx, challenge.ID); err != nil {
+				return nil, err
+			}
+		}
+		return nil, err
+	}
+
+	if err := u.mfaChallengeRepo.MarkUsed(ctx, challenge.ID); err != nil {
+		return nil, err
+	}
+
+	user, err := u.userRepo.GetById(ctx, challenge.UserID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return nil, domain.ErrInvalidMFAChallenge
+		}
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+	if user.IsSuspended() {
+		return nil, domain.ErrAccountSuspended
+	}
+	if user.PasswordResetRequired {
+		return nil, domain.ErrPasswordResetRequired
+	}
+
+	_, _ = u.domainJoiner.JoinByEmailDomain(ctx, user)
+
+	token, refreshToken, err := u.issueTokens(ctx, user, input.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoginOutput{
+		User:         dto.UserToDTO(user),
+		Token:        token,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// startMFAChallenge returns an empty token when the user has not enabled
+// two-factor authentication.
+func (u *AuthUseCaseImpl) startMFAChallenge(ctx context.Context, user *entity.User) (string, error) {
+	mfa, err := u.userMFARepo.GetByUserID(ctx, user.ID)
+	if err != nil {
+		if errors.Is(err, domain.ErrMFANotEnabled) {
+			return "", nil
+		}
+		return "", err
+	}
+	if !mfa.IsConfirmed() {
+		return "", nil
+	}
+
+	raw, hash, err := infraauth.GenerateOpaqueToken()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate mfa token: %w", err)
+	}
+
+	err = u.mfaChallengeRepo.Create(ctx, &entity.MFAChallenge{
+		UserID:    user.ID,
+		TokenHash: hash,
+		ExpiresAt: time.Now().UTC().Add(u.authCfg.MFAChallengeTTL),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to store mfa challenge: %w", err)
+	}
+
+	return raw, nil
+}
+
+// checkSecondFactor accepts either a TOTP code or an unused recovery code.
+func (u *AuthUseCaseImpl) checkSecondFactor(ctx context.Context, mfa *entity.UserMFA, code string) error {
+	counter, totpErr := u.checkTOTPCode(mfa, code)
+	if totpErr == nil {
+		return u.userMFARepo.AdvanceCounter(ctx, mfa.UserID, counter)
+	}
+
+	// Recovery codes must keep working when the TOTP secret cannot be read,
+	// e.g. after the encryption key was rotated.
+	err := u.userMFARepo.UseRecoveryCode(ctx, mfa.UserID, infraauth.HashOpaqueToken(infraauth.NormalizeRecoveryCode(code)))
+	if errors.Is(err, domain.ErrInvalidMFACode) && !errors.Is(totpErr, domain.ErrInvalidMFACode) {
+		return totpErr
+	}
+
+	return err
+}
+
+func (u *AuthUseCaseImpl) checkTOTPCode(mfa *entity.UserMFA, code string) (int64, error) {
+	secret, err := infraauth.OpenSecret(u.authCfg.MFAEncryptionKey, mfa.Secret)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decrypt totp secret: %w", err)
+	}
+
+	counter, ok := infraauth.ValidateTOTPCode(secret, code, time.Now())
+	if !ok || counter <= mfa.LastUsedCounter {
+		return 0, domain.ErrInvalidMFACode
+	}
+
+	return counter, nil
+}
+
+func (u *AuthUseCaseImpl) checkUserPassword(ctx context.Context, userID uuid.UUID, password, code string) error {
+	user, err := u.userRepo.GetById(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return domain.ErrUserNotFound
+		}
+		return fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	return u.reauthenticate(ctx, user, password, code)
+}
+
+// reauthenticate confirms a sensitive action with the password, or with a
+// second-factor code for accounts that have no password. Without either it
+// returns ErrPasswordNotSet so the client can point the user to the reset
+// flow.
+func (u *AuthUseCaseImpl) reauthenticate(ctx context.Context, user *entity.User, password, code string) error {
+	if user.HasPassword() {
+		if !infraauth.CheckPassword(password, user.PasswordHash) {
+			return domain.ErrIncorrectPassword
+		}
+		return nil
+	}
+
+	if code == "" {
+		return domain.ErrPasswordNotSet
+	}
+
+	mfa, err := u.userMFARepo.GetByUserID(ctx, user.ID)
+	if err != nil {
+		if errors.Is(err, domain.ErrMFANotEnabled) {
+			return domain.ErrPasswordNotSet
+		}
+		return err
+	}
+	if !mfa.IsConfirmed() {
+		return domain.ErrPasswordNotSet
+	}
+
+	return u.checkSecondFactor(ctx, mfa, code)
+}
+
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, mfaRecoveryCodeCount)
+	hashes := make([]string, 0, mfaRecoveryCodeCount)
+	for i := 0; i < mfaRecoveryCodeCount; i++ {
+		code, err := infraauth.GenerateRecoveryCode()
+		if err != nil {
+			return nil, nil, err
+		}
+		codes = append(codes, code)
+		hashes = append(hashes, infraauth.HashOpaqueToken(code))
+	}
+
+	return codes, hashes, nil
+}
@@ -0,0 +1,198 @@
+package auth
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/domain/entity"
+	"collabotask/internal/dto"
+	infraauth "collabotask/internal/infrastructure/auth"
+	"collabotask/internal/infrastructure/oidc"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+func (u *AuthUseCaseImpl) StartOIDCLogin(ctx context.Context) (*StartOIDCLoginOutput, error) {
+	if u.oidcProvider == nil {
+		return nil, domain.ErrOIDCNotConfigured
+	}
+
+	state, stateHash, err := infraauth.GenerateOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+	nonce, _, err := infraauth.GenerateOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+	verifier, challenge, err := oidc.GeneratePKCE()
+	if err != nil {
+		return nil, err
+	}
+
+	loginState := &entity.OIDCLoginState{
+		StateHash:    stateHash,
+		Nonce:        nonce,
+		CodeVerifier: verifier,
+		ExpiresAt:    time.Now().UTC().Add(u.oidcCfg.StateTTL),
+	}
+	if err := u.oidcStateRepo.Create(ctx, loginState); err != nil {
+		return nil, err
+	}
+
+	authURL, err := u.oidcProvider.AuthCodeURL(ctx, state, nonce, challenge)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrOIDCLoginFailed, err)
+	}
+
+	return &StartOIDCLoginOutput{
+		AuthURL:   authURL,
+		State:     state,
+		ExpiresAt: loginState.ExpiresAt,
+	}, nil
+}
+
+func (u *AuthUseCaseImpl) CompleteOIDCLogin(ctx context.Context, input CompleteOIDCLoginInput) (*LoginOutput, error) {
+	if u.oidcProvider == nil {
+		return nil, domain.ErrOIDCNotConfigured
+	}
+	if input.State == "" || input.Code == "" {
+		return nil, domain.ErrInvalidOIDCState
+	}
+
+	loginState, err := u.oidcStateRepo.Consume(ctx, infraauth.HashOpaqueToken(input.State))
+	if err != nil {
+		return nil, err
+	}
+	if loginState.IsExpired(time.Now().UTC()) {
+		return nil, domain.ErrInvalidOIDCState
+	}
+
+	identity, err := u.oidcProvider.Exchange(ctx, input.Code, loginState.CodeVerifier, loginState.Nonce)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrOIDCLoginFailed, err)
+	}
+
+	user, err := u.resolveOIDCUser(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.IsSuspended() {
+		return nil, domain.ErrAccountSuspended
+	}
+
+	mfaToken, err := u.startMFAChallenge(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if mfaToken != "" {
+		return &LoginOutput{
+			MFARequired: true,
+			MFAToken:    mfaToken,
+		}, nil
+	}
+
+	_, _ = u.domainJoiner.JoinByEmailDomain(ctx, user)
+
+	token, refreshToken, err := u.issueTokens(ctx, user, input.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoginOutput{
+		User:         dto.UserToDTO(user),
+		Token:        token,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// resolveOIDCUser finds the user linked to the identity. Unlinked identities
+// are matched to an existing account with the same verified email, or
+// provisioned a new one when allowed. A local account that never proved its
+// email, backfilled legacy accounts included, is never linked: whoever registered it may not own the address, and linking would
+// leave their password working on the real owner's account.
+func (u *AuthUseCaseImpl) resolveOIDCUser(ctx context.Context, identity *oidc.Identity) (*entity.User, error) {
+	linked, err := u.userIdentityRepo.GetBySubject(ctx, identity.Issuer, identity.Subject)
+	if err == nil {
+		user, err := u.userRepo.GetById(ctx, linked.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch user: %w", err)
+		}
+		if err := u.userIdentityRepo.TouchLastLogin(ctx, linked.ID); err != nil {
+			return nil, err
+		}
+		return user, nil
+	}
+	if !errors.Is(err, domain.ErrUserIdentityNotFound) {
+		return nil, err
+	}
+
+	if !identity.EmailVerified || !emailRegex.MatchString(identity.Email) {
+		return nil, domain.ErrOIDCEmailNotVerified
+	}
+
+	user, err := u.userRepo.GetByEmail(ctx, identity.Email)
+	if err != nil && !errors.Is(err, domain.ErrUserNotFound) {
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	if user != nil && !user.HasProvenEmail() {
+		return nil, domain.ErrOIDCAccountNotVerified
+	}
+
+	if user == nil {
+		if !u.oidcCfg.AllowProvisioning {
+			return nil, domain.ErrOIDCProvisioningBlocked
+		}
+
+		// Provisioned accounts have no password. They confirm sensitive
+		// actions with a second-factor code or set a password through the
+		// reset flow.
+		user = &entity.User{
+			Email:      identity.Email,
+			Name:       oidcDisplayName(identity),
+			SystemRole: entity.SystemRoleUser,
+		}
+		if err := u.userRepo.Create(ctx, user); err != nil {
+			return nil, fmt.Errorf("failed to create user: %w", err)
+		}
+
+		// The provider vouched for the address, so the new account starts
+		// verified.
+		verifiedAt := time.Now().UTC()
+		if err := u.userRepo.MarkEmailVerified(ctx, user.ID, verifiedAt); err != nil {
+			return nil, err
+		}
+		user.EmailVerifiedAt = &verifiedAt
+
+		// Same as after email verification: a failure leaves the invitations
+		// pending for the explicit accept endpoint.
+		_, _ = u.invitationAcceptor.AcceptPending(ctx, user)
+	}
+
+	err = u.userIdentityRepo.Create(ctx, &entity.UserIdentity{
+		UserID:  user.ID,
+		Issuer:  identity.Issuer,
+		Subject: identity.Subject,
+		Email:   identity.Email,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func oidcDisplayName(identity *oidc.Identity) string {
+	name := identity.Name
+	if name == "" {
+		name, _, _ = strings.Cut(identity.Email, "@")
+	}
+	if len(name) > 255 {
+		name = name[:255]
+	}
+
+	return name
+}
@@ -0,0 +1,110 @@
+package auth
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/domain/entity"
+	infraauth "collabotask/internal/infrastructure/auth"
+	"collabotask/internal/infrastructure/mailer"
+	"collabotask/internal/usecase/common"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+func (u *AuthUseCaseImpl) ForgotPassword(ctx context.Context, input ForgotPasswordInput) error {
+	email := strings.TrimSpace(strings.ToLower(input.Email))
+	if email == "" {
+		return fmt.Errorf("email is required")
+	}
+
+	// Unknown emails are silently accepted so the endpoint cannot be used to
+	// find out which accounts exist.
+	user, err := u.userRepo.GetByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	if err := u.passwordResetRepo.InvalidateByUser(ctx, user.ID); err != nil {
+		return err
+	}
+
+	rawToken, hash, err := infraauth.GenerateOpaqueToken()
+	if err != nil {
+		return err
+	}
+
+	resetToken := &entity.PasswordResetToken{
+		UserID:    user.ID,
+		TokenHash: hash,
+		ExpiresAt: time.Now().UTC().Add(u.authCfg.PasswordResetTTL),
+	}
+	if err := u.passwordResetRepo.Create(ctx, resetToken); err != nil {
+		return err
+	}
+
+	err = u.mailer.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: "Reset your Collabotask password",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nWe received a request to reset your password. Use the link below to choose a new one:\n\n%s\n\nThe link expires in %s. If you did not request this, you can ignore this email.",
+			user.Name,
+			common.BuildTokenURL(u.authCfg.PasswordResetURL, rawToken),
+			u.authCfg.PasswordResetTTL,
+		),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send password reset email: %w", err)
+	}
+
+	return nil
+}
+
+func (u *AuthUseCaseImpl) ResetPassword(ctx context.Context, input ResetPasswordInput) error {
+	if input.Token == "" {
+		return domain.ErrInvalidPasswordResetToken
+	}
+	if err := ValidatePassword(input.NewPassword); err != nil {
+		return err
+	}
+
+	resetToken, err := u.passwordResetRepo.GetByTokenHash(ctx, infraauth.HashOpaqueToken(input.Token))
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidPasswordResetToken) {
+			return domain.ErrInvalidPasswordResetToken
+		}
+		return fmt.Errorf("failed to fetch password reset token: %w", err)
+	}
+	if resetToken.IsUsed() || resetToken.IsExpired(time.Now()) {
+		return domain.ErrInvalidPasswordResetToken
+	}
+
+	hash, err := infraauth.HashPassword(u.authCfg, input.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	// Claiming the token first makes it single-use even under concurrent
+	// requests.
+	if err := u.passwordResetRepo.MarkUsed(ctx, resetToken.ID); err != nil {
+		return err
+	}
+
+	err = u.userRepo.Update(ctx, &entity.User{
+		ID:           resetToken.UserID,
+		PasswordHash: hash,
+		UpdatedAt:    time.Now(),
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return domain.ErrInvalidPasswordResetToken
+		}
+		return fmt.Errorf("failed to update password: %w", err)
+	}
+
+	return u.LogoutAll(ctx, LogoutAllInput{UserID: resetToken.UserID})
+}
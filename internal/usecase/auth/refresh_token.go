@@ -0,0 +1,142 @@
+package auth
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/domain/entity"
+	infraauth "collabotask/internal/infrastructure/auth"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func (u *AuthUseCaseImpl) RefreshToken(ctx context.Context, input RefreshTokenInput) (*RefreshTokenOutput, error) {
+	if input.RefreshToken == "" {
+		return nil, domain.ErrInvalidRefreshToken
+	}
+
+	current, err := u.refreshTokenRepo.GetByTokenHash(ctx, infraauth.HashOpaqueToken(input.RefreshToken))
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidRefreshToken) {
+			return nil, domain.ErrInvalidRefreshToken
+		}
+		return nil, fmt.Errorf("failed to fetch refresh token: %w", err)
+	}
+	if current == nil || current.IsEmpty() || current.IsRevoked() {
+		return nil, domain.ErrInvalidRefreshToken
+	}
+
+	// A refresh token that was already exchanged is being replayed, so the
+	// whole family is treated as compromised.
+	if current.IsUsed() {
+		if err := u.refreshTokenRepo.RevokeFamily(ctx, current.FamilyID); err != nil {
+			return nil, err
+		}
+		return nil, domain.ErrRefreshTokenReused
+	}
+
+	if current.IsExpired(time.Now()) {
+		return nil, domain.ErrInvalidRefreshToken
+	}
+
+	user, err := u.userRepo.GetById(ctx, current.UserID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return nil, domain.ErrInvalidRefreshToken
+		}
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+	if user.IsSuspended() || user.PasswordResetRequired {
+		return nil, domain.ErrInvalidRefreshToken
+	}
+
+	// Families created before sessions were tracked get their session row
+	// here, on first refresh.
+	err = u.sessionRepo.Touch(ctx, &entity.Session{
+		ID:        current.FamilyID,
+		UserID:    user.ID,
+		UserAgent: input.Client.userAgent(),
+		IPAddress: input.Client.IPAddress,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrSessionRevoked) {
+			if errRevoke := u.refreshTokenRepo.RevokeFamily(ctx, current.FamilyID); errRevoke != nil {
+				return nil, errRevoke
+			}
+			return nil, domain.ErrInvalidRefreshToken
+		}
+		return nil, err
+	}
+
+	rawRefreshToken, next, err := u.newRefreshToken(user.ID, current.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.refreshTokenRepo.Rotate(ctx, current.ID, next); err != nil {
+		if errors.Is(err, domain.ErrRefreshTokenReused) {
+			if errRevoke := u.refreshTokenRepo.RevokeFamily(ctx, current.FamilyID); errRevoke != nil {
+				return nil, errRevoke
+			}
+			return nil, domain.ErrRefreshTokenReused
+		}
+		return nil, fmt.Errorf("failed to rotate refresh token: %w", err)
+	}
+
+	token, err := infraauth.GenerateToken(u.authCfg, u.jwtKeys, user.ID, current.FamilyID, string(user.SystemRole))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	return &RefreshTokenOutput{
+		Token:        token,
+		RefreshToken: rawRefreshToken,
+	}, nil
+}
+
+// issueTokens starts a new session for the given user: the session ID is
+// used as the refresh token family and as the "sid" claim of the access
+// token.
+func (u *AuthUseCaseImpl) issueTokens(ctx context.Context, user *entity.User, client ClientInfo) (string, string, error) {
+	session := &entity.Session{
+		ID:        uuid.New(),
+		UserID:    user.ID,
+		UserAgent: client.userAgent(),
+		IPAddress: client.IPAddress,
+	}
+	if err := u.sessionRepo.Touch(ctx, session); err != nil {
+		return "", "", fmt.Errorf("failed to create session: %w", err)
+	}
+
+	token, err := infraauth.GenerateToken(u.authCfg, u.jwtKeys, user.ID, session.ID, string(user.SystemRole))
+	if err != nil {
+		return "", "", fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	rawRefreshToken, refreshToken, err := u.newRefreshToken(user.ID, session.ID)
+	if err != nil {
+		return "", "", err
+	}
+
+	if err := u.refreshTokenRepo.Create(ctx, refreshToken); err != nil {
+		return "", "", fmt.Errorf("failed to store refresh token: %w", err)
+	}
+
+	return token, rawRefreshToken, nil
+}
+
+func (u *AuthUseCaseImpl) newRefreshToken(userID, familyID uuid.UUID) (string, *entity.RefreshToken, error) {
+	raw, hash, err := infraauth.GenerateOpaqueToken()
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+
+	return raw, &entity.RefreshToken{
+		UserID:    userID,
+		FamilyID:  familyID,
+		TokenHash: hash,
+		ExpiresAt: time.Now().UTC().Add(u.authCfg.RefreshTokenExpiration),
+	}, nil
+}
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 )
 
 func (u *AuthUseCaseImpl) Register(ctx context.Context, input RegisterInput) (*RegisterOutput, error) {
@@ -24,6 +25,14 @@ func (u *AuthUseCaseImpl) Register(ctx context.Context, input RegisterInput) (*R
 		return nil, domain.ErrEmailAlreadyExists
 	}
 
+	var invitation *entity.WorkspaceInvitation
+	if input.InvitationToken != "" {
+		invitation, err = u.invitationAcceptor.Lookup(ctx, input.InvitationToken, input.Email)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	hash, err := infraauth.HashPassword(u.authCfg, input.Password)
 	if err != nil {
 		return nil, err
@@ -43,13 +52,40 @@ func (u *AuthUseCaseImpl) Register(ctx context.Context, input RegisterInput) (*R
 		return nil, fmt.Errorf("failed to create user: %w", err)
 	}
 
-	token, err := infraauth.GenerateToken(u.authCfg, user.ID, string(user.SystemRole))
+	if invitation != nil {
+		// The invitation reached this address, which proves it as well as a
+		// verification email would. Every pending invitation for it is
+		// accepted; failures leave them pending for the accept endpoint.
+		verifiedAt := time.Now().UTC()
+		if err := u.userRepo.MarkEmailVerified(ctx, user.ID, verifiedAt); err != nil {
+			return nil, fmt.Errorf("failed to verify email: %w", err)
+		}
+		user.EmailVerifiedAt = &verifiedAt
+		_, _ = u.invitationAcceptor.AcceptPending(ctx, user)
+	} else {
+		// The account already exists at this point; a failed delivery can be
+		// retried through the resend endpoint instead of failing registration.
+		_ = u.sendVerificationEmail(ctx, user)
+	}
+
+	// Only joins when the address is already proven; otherwise email
+	// verification takes care of it.
+	_, _ = u.domainJoiner.JoinByEmailDomain(ctx, user)
+
+	if u.authCfg.RequireVerifiedEmailForLogin && !user.IsEmailVerified() {
+		return &RegisterOutput{
+			User: dto.UserToDTO(user),
+		}, nil
+	}
+
+	token, refreshToken, err := u.issueTokens(ctx, user, input.Client)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate token: %w", err)
+		return nil, err
 	}
 
 	return &RegisterOutput{
-		User:  dto.UserToDTO(user),
-		Token: token,
+		User:         dto.UserToDTO(user),
+		Token:        token,
+		RefreshToken: refreshToken,
 	}, nil
 }
@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/domain/entity"
+	"collabotask/internal/dto"
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+func (u *AuthUseCaseImpl) UpdateProfile(ctx context.Context, input UpdateProfileInput) (*dto.UserDTO, error) {
+	if input.AvatarURL != nil {
+		avatarURL := strings.TrimSpace(*input.AvatarURL)
+		input.AvatarURL = &avatarURL
+	}
+
+	if err := validator.Struct(input); err != nil {
+		return nil, fmt.Errorf("failed to validate update profile input: %w", err)
+	}
+
+	atLeastOne := validator.AtLeastOneProvided(input.Name) || input.AvatarURLPresent
+	if !atLeastOne {
+		return nil, domain.ErrAtLeastOneProvided
+	}
+
+	patch := &entity.User{
+		ID:        input.UserID,
+		UpdatedAt: time.Now(),
+	}
+	if input.Name != nil {
+		name := strings.TrimSpace(*input.Name)
+		if name == "" {
+			return nil, domain.ErrAtLeastOneProvided
+		}
+		patch.Name = name
+	}
+	if input.AvatarURLPresent {
+		// An empty avatar URL tells the repository to clear the column.
+		avatarURL := ""
+		if input.AvatarURL != nil {
+			avatarURL = *input.AvatarURL
+		}
+		patch.AvatarURL = &avatarURL
+	}
+
+	if err := u.userRepo.Update(ctx, patch); err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to update profile: %w", err)
+	}
+
+	result := dto.UserToDTO(patch)
+
+	return &result, nil
+}
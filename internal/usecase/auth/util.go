@@ -39,8 +39,21 @@ func ValidateRegisterInput(input RegisterInput) error {
 	if input.Name == "" {
 		return fmt.Errorf("name is required")
 	}
-	if len(input.Password) < minPasswordLen {
+	return ValidatePassword(input.Password)
+}
+
+func ValidatePassword(password string) error {
+	if len(password) < minPasswordLen {
 		return fmt.Errorf("password must be at least %d characters", minPasswordLen)
 	}
 	return nil
 }
+
+const maxUserAgentLen = 500
+
+func (c ClientInfo) userAgent() string {
+	if len(c.UserAgent) > maxUserAgentLen {
+		return c.UserAgent[:maxUserAgentLen]
+	}
+	return c.UserAgent
+}
@@ -0,0 +1,110 @@
+package auth
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/domain/entity"
+	infraauth "collabotask/internal/infrastructure/auth"
+	"collabotask/internal/infrastructure/mailer"
+	"collabotask/internal/usecase/common"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+func (u *AuthUseCaseImpl) VerifyEmail(ctx context.Context, input VerifyEmailInput) error {
+	if input.Token == "" {
+		return domain.ErrInvalidEmailVerificationToken
+	}
+
+	verificationToken, err := u.emailVerificationRepo.GetByTokenHash(ctx, infraauth.HashOpaqueToken(input.Token))
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidEmailVerificationToken) {
+			return domain.ErrInvalidEmailVerificationToken
+		}
+		return fmt.Errorf("failed to fetch email verification token: %w", err)
+	}
+	if verificationToken.IsUsed() || verificationToken.IsExpired(time.Now()) {
+		return domain.ErrInvalidEmailVerificationToken
+	}
+
+	if err := u.emailVerificationRepo.MarkUsed(ctx, verificationToken.ID); err != nil {
+		return err
+	}
+
+	if err := u.userRepo.MarkEmailVerified(ctx, verificationToken.UserID, time.Now()); err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return domain.ErrInvalidEmailVerificationToken
+		}
+		return fmt.Errorf("failed to verify email: %w", err)
+	}
+
+	// Workspace invitations sent to the now proven address turn into
+	// memberships, and workspaces that verified its domain pick the user up.
+	// Failures leave invitations pending for the accept endpoint rather than
+	// failing a verification that already succeeded.
+	if user, err := u.userRepo.GetById(ctx, verificationToken.UserID); err == nil {
+		_, _ = u.invitationAcceptor.AcceptPending(ctx, user)
+		_, _ = u.domainJoiner.JoinByEmailDomain(ctx, user)
+	}
+
+	return nil
+}
+
+func (u *AuthUseCaseImpl) ResendVerificationEmail(ctx context.Context, input ResendVerificationEmailInput) error {
+	email := strings.TrimSpace(strings.ToLower(input.Email))
+	if email == "" {
+		return fmt.Errorf("email is required")
+	}
+
+	user, err := u.userRepo.GetByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to fetch user: %w", err)
+	}
+	// Legacy accounts may still verify to prove their address.
+	if user.HasProvenEmail() {
+		return nil
+	}
+
+	return u.sendVerificationEmail(ctx, user)
+}
+
+func (u *AuthUseCaseImpl) sendVerificationEmail(ctx context.Context, user *entity.User) error {
+	if err := u.emailVerificationRepo.InvalidateByUser(ctx, user.ID); err != nil {
+		return err
+	}
+
+	rawToken, hash, err := infraauth.GenerateOpaqueToken()
+	if err != nil {
+		return err
+	}
+
+	verificationToken := &entity.EmailVerificationToken{
+		UserID:    user.ID,
+		TokenHash: hash,
+		ExpiresAt: time.Now().UTC().Add(u.authCfg.EmailVerificationTTL),
+	}
+	if err := u.emailVerificationRepo.Create(ctx, verificationToken); err != nil {
+		return err
+	}
+
+	err = u.mailer.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: "Verify your Collabotask email",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nPlease confirm your email address by opening the link below:\n\n%s\n\nThe link expires in %s.",
+			user.Name,
+			common.BuildTokenURL(u.authCfg.EmailVerificationURL, rawToken),
+			u.authCfg.EmailVerificationTTL,
+		),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send verification email: %w", err)
+	}
+
+	return nil
+}
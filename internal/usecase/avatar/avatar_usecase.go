@@ -0,0 +1,40 @@
+package avatar
+
+import (
+	"collabotask/internal/config"
+	"collabotask/internal/domain/repository"
+	"collabotask/internal/infrastructure/storage"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type AvatarUseCaseImpl struct {
+	userRepo   repository.UserRepository
+	blobStore  storage.BlobStore
+	storageCfg *config.StorageConfig
+}
+
+func NewAvatarUseCase(
+	userRepo repository.UserRepository,
+	blobStore storage.BlobStore,
+	storageCfg *config.StorageConfig,
+) AvatarUseCase {
+	return &AvatarUseCaseImpl{
+		userRepo:   userRepo,
+		blobStore:  blobStore,
+		storageCfg: storageCfg,
+	}
+}
+
+func avatarKey(userID uuid.UUID, size int) string {
+	return fmt.Sprintf("avatars/%s/%d.png", userID, size)
+}
+
+// avatarURL is the public address of the user's uploaded avatar. It is the
+// prefix that marks users.avatar_url as managed by this use case rather than
+// an external link.
+func (au *AvatarUseCaseImpl) avatarURL(userID uuid.UUID) string {
+	return fmt.Sprintf("%s/%s/avatar", strings.TrimSuffix(au.storageCfg.AvatarBaseURL, "/"), userID)
+}
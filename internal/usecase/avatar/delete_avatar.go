@@ -0,0 +1,43 @@
+package avatar
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/domain/entity"
+	"collabotask/internal/dto"
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+func (au *AvatarUseCaseImpl) DeleteAvatar(ctx context.Context, input DeleteAvatarInput) (*dto.UserDTO, error) {
+	if err := validator.Struct(input); err != nil {
+		return nil, fmt.Errorf("failed to validate delete avatar input: %w", err)
+	}
+
+	// Clear the column first so a failed blob cleanup never leaves the user
+	// pointing at a missing image.
+	avatarURL := ""
+	patch := &entity.User{
+		ID:        input.UserID,
+		AvatarURL: &avatarURL,
+		UpdatedAt: time.Now(),
+	}
+	if err := au.userRepo.Update(ctx, patch); err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to clear avatar: %w", err)
+	}
+
+	for _, size := range []int{AvatarSizeLarge, AvatarSizeSmall} {
+		if err := au.blobStore.Delete(ctx, avatarKey(input.UserID, size)); err != nil {
+			return nil, fmt.Errorf("failed to delete avatar: %w", err)
+		}
+	}
+
+	result := dto.UserToDTO(patch)
+
+	return &result, nil
+}
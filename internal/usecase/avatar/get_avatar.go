@@ -0,0 +1,55 @@
+package avatar
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/infrastructure/storage"
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+func (au *AvatarUseCaseImpl) GetAvatar(ctx context.Context, input GetAvatarInput) (*GetAvatarOutput, error) {
+	if err := validator.Struct(input); err != nil {
+		return nil, fmt.Errorf("failed to validate get avatar input: %w", err)
+	}
+
+	user, err := au.userRepo.GetById(ctx, input.UserID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return nil, domain.ErrAvatarNotFound
+		}
+		return nil, err
+	}
+
+	// Only serve blobs while the profile still points at them; an external
+	// URL or a cleared avatar hides whatever was uploaded before.
+	if user.AvatarURL == nil || !strings.HasPrefix(*user.AvatarURL, au.avatarURL(user.ID)+"?") {
+		return nil, domain.ErrAvatarNotFound
+	}
+
+	size := input.Size
+	if size == 0 {
+		size = AvatarSizeLarge
+	}
+	key := avatarKey(user.ID, size)
+
+	if signer, ok := au.blobStore.(storage.URLSigner); ok {
+		signedURL, err := signer.SignedURL(ctx, key, au.storageCfg.SignedURLTTL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign avatar url: %w", err)
+		}
+		return &GetAvatarOutput{RedirectURL: signedURL}, nil
+	}
+
+	object, err := au.blobStore.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, domain.ErrAvatarNotFound
+		}
+		return nil, fmt.Errorf("failed to load avatar: %w", err)
+	}
+
+	return &GetAvatarOutput{Object: object}, nil
+}
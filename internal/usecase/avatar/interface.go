@@ -0,0 +1,43 @@
+package avatar
+
+import (
+	"collabotask/internal/dto"
+	"collabotask/internal/infrastructure/storage"
+	"context"
+	"io"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AvatarSizeLarge = 256
+	AvatarSizeSmall = 64
+)
+
+type AvatarUseCase interface {
+	UploadAvatar(ctx context.Context, input UploadAvatarInput) (*dto.UserDTO, error)
+	DeleteAvatar(ctx context.Context, input DeleteAvatarInput) (*dto.UserDTO, error)
+	GetAvatar(ctx context.Context, input GetAvatarInput) (*GetAvatarOutput, error)
+}
+
+type UploadAvatarInput struct {
+	UserID  uuid.UUID `validate:"required"`
+	Content io.Reader `validate:"required"`
+}
+
+type DeleteAvatarInput struct {
+	UserID uuid.UUID `validate:"required"`
+}
+
+type GetAvatarInput struct {
+	UserID uuid.UUID `validate:"required"`
+	// Size is the edge length in pixels; zero selects the large variant.
+	Size int `validate:"omitempty,oneof=64 256"`
+}
+
+// GetAvatarOutput carries either a signed URL to redirect to, when the blob
+// store supports them, or the opened image to stream.
+type GetAvatarOutput struct {
+	RedirectURL string
+	Object      *storage.Object
+}
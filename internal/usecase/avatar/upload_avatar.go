@@ -0,0 +1,83 @@
+package avatar
+
+import (
+	"bytes"
+	"collabotask/internal/domain"
+	"collabotask/internal/domain/entity"
+	"collabotask/internal/dto"
+	"collabotask/internal/infrastructure/imaging"
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"time"
+)
+
+func (au *AvatarUseCaseImpl) UploadAvatar(ctx context.Context, input UploadAvatarInput) (*dto.UserDTO, error) {
+	if err := validator.Struct(input); err != nil {
+		return nil, fmt.Errorf("failed to validate upload avatar input: %w", err)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(input.Content, au.storageCfg.AvatarMaxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read avatar: %w", err)
+	}
+	if int64(len(data)) > au.storageCfg.AvatarMaxBytes {
+		return nil, domain.ErrAvatarTooLarge
+	}
+
+	img, err := imaging.Decode(data, au.storageCfg.AvatarMaxPixels)
+	if err != nil {
+		switch {
+		case errors.Is(err, imaging.ErrUnsupportedFormat):
+			return nil, domain.ErrUnsupportedAvatarType
+		case errors.Is(err, imaging.ErrTooManyPixels):
+			return nil, domain.ErrAvatarDimensionsTooLarge
+		}
+		return nil, fmt.Errorf("failed to decode avatar: %w", err)
+	}
+
+	// The small variant is scaled from the large one, which is cheaper and
+	// indistinguishable at these sizes.
+	large := imaging.Thumbnail(img, AvatarSizeLarge)
+	variants := []struct {
+		size int
+		img  image.Image
+	}{
+		{AvatarSizeLarge, large},
+		{AvatarSizeSmall, imaging.Thumbnail(large, AvatarSizeSmall)},
+	}
+
+	for _, variant := range variants {
+		encoded, err := imaging.EncodePNG(variant.img)
+		if err != nil {
+			return nil, err
+		}
+		key := avatarKey(input.UserID, variant.size)
+		if err := au.blobStore.Put(ctx, key, bytes.NewReader(encoded), int64(len(encoded)), "image/png"); err != nil {
+			return nil, fmt.Errorf("failed to store avatar: %w", err)
+		}
+	}
+
+	// Blob keys are stable, so the version parameter is what makes clients
+	// and caches pick up a new upload.
+	now := time.Now()
+	avatarURL := fmt.Sprintf("%s?v=%d", au.avatarURL(input.UserID), now.Unix())
+	patch := &entity.User{
+		ID:        input.UserID,
+		AvatarURL: &avatarURL,
+		UpdatedAt: now,
+	}
+	if err := au.userRepo.Update(ctx, patch); err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to update avatar: %w", err)
+	}
+
+	result := dto.UserToDTO(patch)
+
+	return &result, nil
+}
@@ -10,6 +10,7 @@ type BoardUseCaseImpl struct {
 	userRepo            repository.UserRepository
 	columnRepo          repository.ColumnRepository
 	cardRepo            repository.CardRepository
+	groupRepo           repository.WorkspaceGroupRepository
 }
 
 func NewBoardUseCase(
@@ -20,6 +21,7 @@ func NewBoardUseCase(
 	userRepo repository.UserRepository,
 	columnRepo repository.ColumnRepository,
 	cardRepo repository.CardRepository,
+	groupRepo repository.WorkspaceGroupRepository,
 ) BoardUseCase {
 	return &BoardUseCaseImpl{
 		boardRepo:           boardRepo,
@@ -29,5 +31,6 @@ func NewBoardUseCase(
 		userRepo:            userRepo,
 		columnRepo:          columnRepo,
 		cardRepo:            cardRepo,
+		groupRepo:           groupRepo,
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"collabotask/internal/dto"
 	"collabotask/internal/infrastructure/validator"
 	"context"
+	"errors"
 	"fmt"
 )
 
@@ -16,16 +17,19 @@ func (bu *BoardUseCaseImpl) CreateBoard(ctx context.Context, input CreateBoardIn
 		return nil, fmt.Errorf("create board validation failed: %w", err)
 	}
 
-	// For now we just check whether the user exist
-	// in the workspace or not, maybe later we will add
-	// workspace admin or member check
-	exists, err := bu.workspaceMemberRepo.IsUserExists(ctx, input.WorkspaceID, input.RequesterID)
+	workspaceMember, err := bu.workspaceMemberRepo.GetByWorkspaceAndUser(ctx, input.WorkspaceID, input.RequesterID)
 	if err != nil {
+		if errors.Is(err, domain.ErrMemberNotFound) {
+			return nil, domain.ErrUserNotInWorkspace
+		}
 		return nil, fmt.Errorf("failed to check workspace membership: %w", err)
 	}
-	if !exists {
+	if workspaceMember == nil || workspaceMember.IsEmpty() {
 		return nil, domain.ErrUserNotInWorkspace
 	}
+	if workspaceMember.IsGuest() {
+		return nil, domain.ErrWorkspaceGuest
+	}
 
 	var description *string
 	if input.Description != nil && *input.Description != "" {
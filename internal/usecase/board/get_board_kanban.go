@@ -42,7 +42,11 @@ func (bu *BoardUseCaseImpl) GetBoardKanban(ctx context.Context, input GetBoardKa
 		boardMembership = nil
 	}
 
-	hasAccess := workspaceMembership.IsAdmin() || board.CreatedBy == input.RequesterID || (boardMembership != nil && !boardMembership.IsEmpty())
+	// Guests only reach boards they were explicitly added to.
+	hasAccess := boardMembership != nil && !boardMembership.IsEmpty()
+	if !workspaceMembership.IsGuest() {
+		hasAccess = hasAccess || workspaceMembership.IsAdmin() || board.CreatedBy == input.RequesterID
+	}
 	if !hasAccess {
 		return nil, domain.ErrBoardAccessDenied
 	}
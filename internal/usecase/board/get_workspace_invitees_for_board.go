@@ -34,6 +34,9 @@ func (bu *BoardUseCaseImpl) GetWorkspaceInviteesForBoard(ctx context.Context, in
 	if workspaceMember == nil || workspaceMember.IsEmpty() {
 		return nil, domain.ErrUserNotInWorkspace
 	}
+	if workspaceMember.IsGuest() {
+		return nil, domain.ErrWorkspaceGuest
+	}
 
 	boardMember, err := bu.boardMemberRepo.GetMemberByBoardAndUser(ctx, input.BoardID, input.RequesterID)
 	if err != nil {
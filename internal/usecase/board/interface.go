@@ -19,6 +19,8 @@ type BoardUseCase interface {
 	UpdateBoard(ctx context.Context, input UpdateBoardInput) (*UpdateBoardOutput, error)
 	SetArchived(ctx context.Context, input SetArchivedInput) (*SetArchivedOutput, error)
 	GetBoardKanban(ctx context.Context, input GetBoardKanbanInput) (*GetBoardKanbanOutput, error)
+	UnlinkGroup(ctx context.Context, input UnlinkGroupInput) error
+	TransferOwnership(ctx context.Context, input TransferOwnershipInput) (*TransferOwnershipOutput, error)
 }
 
 type CreateBoardInput struct {
@@ -52,10 +54,23 @@ type GetBoardsOutput struct {
 }
 
 type InviteMemberInput struct {
-	RequesterID uuid.UUID   `validate:"required"`
-	WorkspaceID uuid.UUID   `validate:"required"`
-	BoardID     uuid.UUID   `validate:"required"`
-	UserIDs     []uuid.UUID `validate:"required,min=1,dive"`
+	RequesterID uuid.UUID `validate:"required"`
+	WorkspaceID uuid.UUID `validate:"required"`
+	BoardID     uuid.UUID `validate:"required"`
+	// Exactly one of UserIDs and GroupID is set. A group invite adds the
+	// group's members, skipping those already on the board.
+	UserIDs []uuid.UUID `validate:"required_without=GroupID,excluded_with=GroupID,omitempty,min=1,dive"`
+	GroupID *uuid.UUID
+	// SyncGroup links the group to the board so later group membership
+	// changes are applied to the board too.
+	SyncGroup bool
+}
+
+type UnlinkGroupInput struct {
+	RequesterID uuid.UUID `validate:"required"`
+	WorkspaceID uuid.UUID `validate:"required"`
+	BoardID     uuid.UUID `validate:"required"`
+	GroupID     uuid.UUID `validate:"required"`
 }
 
 type RemoveMemberInput struct {
@@ -117,3 +132,14 @@ type GetBoardKanbanInput struct {
 type GetBoardKanbanOutput struct {
 	Columns []dto.ColumnWithCardsDTO
 }
+
+type TransferOwnershipInput struct {
+	RequesterID uuid.UUID `validate:"required"`
+	WorkspaceID uuid.UUID `validate:"required"`
+	BoardID     uuid.UUID `validate:"required"`
+	NewOwnerID  uuid.UUID `validate:"required"`
+}
+
+type TransferOwnershipOutput struct {
+	Board dto.BoardDTO
+}
@@ -6,6 +6,8 @@ import (
 	"collabotask/internal/infrastructure/validator"
 	"context"
 	"fmt"
+
+	"github.com/google/uuid"
 )
 
 func (bu *BoardUseCaseImpl) InviteMember(ctx context.Context, input InviteMemberInput) error {
@@ -36,6 +38,10 @@ func (bu *BoardUseCaseImpl) InviteMember(ctx context.Context, input InviteMember
 		return domain.ErrBoardPermissionDenied
 	}
 
+	if input.GroupID != nil {
+		return bu.inviteGroup(ctx, board, *input.GroupID, input.RequesterID, input.SyncGroup)
+	}
+
 	users, err := bu.userRepo.GetByIds(ctx, input.UserIDs)
 	if err != nil {
 		return fmt.Errorf("failed to fetch users data: %w", err)
@@ -84,3 +90,21 @@ func (bu *BoardUseCaseImpl) InviteMember(ctx context.Context, input InviteMember
 
 	return nil
 }
+
+func (bu *BoardUseCaseImpl) inviteGroup(ctx context.Context, board *entity.Board, groupID, requesterID uuid.UUID, sync bool) error {
+	if _, err := bu.groupRepo.GetByID(ctx, groupID, board.WorkspaceID); err != nil {
+		return err
+	}
+
+	added, err := bu.groupRepo.AddToBoard(ctx, groupID, board.ID, requesterID, sync)
+	if err != nil {
+		return err
+	}
+
+	// Linking is still useful when everyone is already on the board.
+	if added == 0 && !sync {
+		return domain.ErrBoardNoMembersToInvite
+	}
+
+	return nil
+}
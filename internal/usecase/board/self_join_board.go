@@ -35,6 +35,9 @@ func (bu *BoardUseCaseImpl) SelfJoinBoard(ctx context.Context, input SelfJoinBoa
 	if workspaceMember == nil || workspaceMember.IsEmpty() {
 		return domain.ErrUserNotInWorkspace
 	}
+	if workspaceMember.IsGuest() {
+		return domain.ErrWorkspaceGuest
+	}
 
 	boardMember, err := bu.boardMemberRepo.GetMemberByBoardAndUser(ctx, input.BoardID, input.RequesterID)
 	if err != nil {
@@ -0,0 +1,56 @@
+package board
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/dto"
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"errors"
+	"fmt"
+)
+
+func (bu *BoardUseCaseImpl) TransferOwnership(ctx context.Context, input TransferOwnershipInput) (*TransferOwnershipOutput, error) {
+	if err := validator.Struct(input); err != nil {
+		return nil, fmt.Errorf("failed to validate transfer board ownership input: %w", err)
+	}
+
+	board, err := bu.boardRepo.GetByID(ctx, input.BoardID)
+	if err != nil {
+		if errors.Is(err, domain.ErrBoardNotFound) {
+			return nil, domain.ErrBoardNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch board detail: %w", err)
+	}
+	if board == nil || board.IsEmpty() || board.IsArchived || board.WorkspaceID != input.WorkspaceID {
+		return nil, domain.ErrBoardNotFound
+	}
+
+	requesterMember, err := bu.workspaceMemberRepo.GetByWorkspaceAndUser(ctx, input.WorkspaceID, input.RequesterID)
+	if err != nil || requesterMember == nil || requesterMember.IsEmpty() {
+		return nil, domain.ErrUserNotInWorkspace
+	}
+	if requesterMember.IsGuest() || (board.CreatedBy != input.RequesterID && !requesterMember.IsAdmin()) {
+		return nil, domain.ErrBoardPermissionDenied
+	}
+	if board.CreatedBy == input.NewOwnerID {
+		return nil, domain.ErrAlreadyBoardOwner
+	}
+
+	// Guests cannot create boards, so they cannot be handed one either.
+	newOwnerMember, err := bu.workspaceMemberRepo.GetByWorkspaceAndUser(ctx, input.WorkspaceID, input.NewOwnerID)
+	if err != nil || newOwnerMember == nil || newOwnerMember.IsEmpty() {
+		return nil, domain.ErrUserNotInWorkspace
+	}
+	if newOwnerMember.IsGuest() {
+		return nil, domain.ErrWorkspaceGuest
+	}
+
+	board, err = bu.boardRepo.TransferOwnership(ctx, input.BoardID, board.CreatedBy, input.NewOwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TransferOwnershipOutput{
+		Board: dto.BoardToDTO(board),
+	}, nil
+}
@@ -0,0 +1,36 @@
+package board
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"fmt"
+)
+
+func (bu *BoardUseCaseImpl) UnlinkGroup(ctx context.Context, input UnlinkGroupInput) error {
+	if err := validator.Struct(input); err != nil {
+		return fmt.Errorf("failed to validate unlink group input: %w", err)
+	}
+
+	board, err := bu.boardRepo.GetByID(ctx, input.BoardID)
+	if err != nil || board == nil || board.WorkspaceID != input.WorkspaceID {
+		return domain.ErrBoardNotFound
+	}
+
+	workspaceMember, err := bu.workspaceMemberRepo.GetByWorkspaceAndUser(ctx, input.WorkspaceID, input.RequesterID)
+	if err != nil || workspaceMember == nil || workspaceMember.IsEmpty() {
+		return domain.ErrUserNotInWorkspace
+	}
+
+	boardMember, err := bu.boardMemberRepo.GetMemberByBoardAndUser(ctx, input.BoardID, input.RequesterID)
+	if err != nil {
+		return fmt.Errorf("failed to check requester permission: %w", err)
+	}
+
+	canManage := canManageBoardMembers(board.CreatedBy, input.RequesterID, boardMember, workspaceMember)
+	if !canManage {
+		return domain.ErrBoardPermissionDenied
+	}
+
+	return bu.groupRepo.UnlinkBoard(ctx, input.GroupID, input.BoardID)
+}
@@ -6,7 +6,13 @@ import (
 	"github.com/google/uuid"
 )
 
+// canManageBoardMembers never lets workspace guests manage a board, even one
+// they created or own from before they became a guest.
 func canManageBoardMembers(createdBy, requesterID uuid.UUID, boardMember *entity.BoardMember, workspaceMember *entity.WorkspaceMember) bool {
+	if workspaceMember.IsGuest() {
+		return false
+	}
+
 	return createdBy == requesterID ||
 		(boardMember != nil && !boardMember.IsEmpty() && boardMember.IsOwner()) ||
 		(workspaceMember.IsAdmin() && boardMember != nil && !boardMember.IsEmpty())
@@ -0,0 +1,76 @@
+package common
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/domain/entity"
+	"collabotask/internal/domain/repository"
+	infraauth "collabotask/internal/infrastructure/auth"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// lastUsedResolution limits how often last_used_at is written for a token
+// that is used in a tight loop.
+const lastUsedResolution = time.Minute
+
+type AccessTokenAuthenticator interface {
+	// Authenticate resolves a raw personal access token, returning
+	// domain.ErrInvalidAccessToken when it is unknown, expired, revoked or
+	// belongs to a suspended user or one who must reset their password.
+	Authenticate(ctx context.Context, rawToken string) (*entity.PersonalAccessToken, error)
+}
+
+type AccessTokenAuthenticatorImpl struct {
+	accessTokenRepo repository.PersonalAccessTokenRepository
+	userRepo        repository.UserRepository
+}
+
+func NewAccessTokenAuthenticator(
+	accessTokenRepo repository.PersonalAccessTokenRepository,
+	userRepo repository.UserRepository,
+) AccessTokenAuthenticator {
+	return &AccessTokenAuthenticatorImpl{
+		accessTokenRepo: accessTokenRepo,
+		userRepo:        userRepo,
+	}
+}
+
+func (a *AccessTokenAuthenticatorImpl) Authenticate(ctx context.Context, rawToken string) (*entity.PersonalAccessToken, error) {
+	if !infraauth.IsPersonalAccessToken(rawToken) {
+		return nil, domain.ErrInvalidAccessToken
+	}
+
+	token, err := a.accessTokenRepo.GetByTokenHash(ctx, infraauth.HashOpaqueToken(rawToken))
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidAccessToken) {
+			return nil, domain.ErrInvalidAccessToken
+		}
+		return nil, fmt.Errorf("failed to fetch access token: %w", err)
+	}
+
+	now := time.Now().UTC()
+	if token.IsRevoked() || token.IsExpired(now) {
+		return nil, domain.ErrInvalidAccessToken
+	}
+
+	user, err := a.userRepo.GetById(ctx, token.UserID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return nil, domain.ErrInvalidAccessToken
+		}
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+	if user.IsSuspended() || user.PasswordResetRequired {
+		return nil, domain.ErrInvalidAccessToken
+	}
+
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) >= lastUsedResolution {
+		if err := a.accessTokenRepo.TouchLastUsed(ctx, token.ID, now); err != nil {
+			return nil, err
+		}
+	}
+
+	return token, nil
+}
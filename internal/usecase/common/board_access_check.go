@@ -51,7 +51,12 @@ func (ba *BoardAccessCheckerImpl) Check(ctx context.Context, boardID, requesterI
 	}
 
 	boardMembership, _ := ba.boardMemberRepo.GetMemberByBoardAndUser(ctx, boardID, requesterID)
-	hasAccess := workspaceMembership.IsAdmin() || board.CreatedBy == requesterID || (boardMembership != nil && !boardMembership.IsEmpty())
+	isBoardMember := boardMembership != nil && !boardMembership.IsEmpty()
+	hasAccess := isBoardMember
+	// Guests only reach boards they were explicitly added to.
+	if !workspaceMembership.IsGuest() {
+		hasAccess = hasAccess || workspaceMembership.IsAdmin() || board.CreatedBy == requesterID
+	}
 	if !hasAccess {
 		return nil, domain.ErrBoardAccessDenied
 	}
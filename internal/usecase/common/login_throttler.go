@@ -0,0 +1,141 @@
+package common
+
+import (
+	"collabotask/internal/config"
+	"collabotask/pkg/logger"
+	"sync"
+	"time"
+)
+
+// LoginThrottler tracks failed logins per account and per client IP.
+type LoginThrottler interface {
+	// RetryAfter returns how long logins for the account or from the IP are
+	// still blocked. Zero means an attempt may be made.
+	RetryAfter(email, ip string) time.Duration
+	RecordFailure(email, ip string)
+	RecordSuccess(email, ip string)
+}
+
+type loginFailures struct {
+	count       int
+	lastFailure time.Time
+	blockedTill time.Time
+}
+
+// LoginThrottlerImpl keeps its counters in process memory. Once a key has
+// more failures than allowed, every further failure doubles the wait,
+// starting at LoginBackoffBase and capped at LoginLockoutDuration. Counters
+// are forgotten after LoginFailureWindow without failures.
+type LoginThrottlerImpl struct {
+	cfg *config.AuthConfig
+	log *logger.Logger
+
+	mu       sync.Mutex
+	accounts map[string]*loginFailures
+	ips      map[string]*loginFailures
+	prunedAt time.Time
+}
+
+func NewLoginThrottler(cfg *config.AuthConfig, log *logger.Logger) LoginThrottler {
+	return &LoginThrottlerImpl{
+		cfg:      cfg,
+		log:      log,
+		accounts: make(map[string]*loginFailures),
+		ips:      make(map[string]*loginFailures),
+	}
+}
+
+func (t *LoginThrottlerImpl) RetryAfter(email, ip string) time.Duration {
+	now := time.Now()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var wait time.Duration
+	if f, ok := t.accounts[email]; ok && f.blockedTill.After(now) {
+		wait = f.blockedTill.Sub(now)
+	}
+	if f, ok := t.ips[ip]; ok && f.blockedTill.After(now) && f.blockedTill.Sub(now) > wait {
+		wait = f.blockedTill.Sub(now)
+	}
+
+	return wait
+}
+
+func (t *LoginThrottlerImpl) RecordFailure(email, ip string) {
+	now := time.Now()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.pruneLocked(now)
+	t.recordLocked(t.accounts, "account", email, t.cfg.LoginMaxAccountFailures, now)
+	if ip != "" {
+		t.recordLocked(t.ips, "ip", ip, t.cfg.LoginMaxIPFailures, now)
+	}
+}
+
+// RecordSuccess only clears the account counter. Keeping the IP counter stops
+// an attacker from resetting it by logging into an account of their own.
+func (t *LoginThrottlerImpl) RecordSuccess(email, ip string) {
+	t.mu.Lock()
+	delete(t.accounts, email)
+	t.mu.Unlock()
+}
+
+func (t *LoginThrottlerImpl) recordLocked(failures map[string]*loginFailures, scope, key string, maxFailures int, now time.Time) {
+	f, ok := failures[key]
+	if !ok || t.isStale(f, now) {
+		f = &loginFailures{}
+		failures[key] = f
+	}
+
+	f.count++
+	f.lastFailure = now
+	if f.count <= maxFailures {
+		return
+	}
+
+	wait := t.backoff(f.count - maxFailures)
+	f.blockedTill = now.Add(wait)
+
+	t.log.WithFields(map[string]interface{}{
+		"event":       "login_throttled",
+		"scope":       scope,
+		"key":         key,
+		"failures":    f.count,
+		"retry_after": wait.String(),
+		"locked_out":  wait >= t.cfg.LoginLockoutDuration,
+	}).Warn("security: repeated failed logins")
+}
+
+func (t *LoginThrottlerImpl) backoff(excess int) time.Duration {
+	wait := t.cfg.LoginBackoffBase
+	for i := 1; i < excess && wait < t.cfg.LoginLockoutDuration; i++ {
+		wait *= 2
+	}
+	if wait > t.cfg.LoginLockoutDuration {
+		wait = t.cfg.LoginLockoutDuration
+	}
+
+	return wait
+}
+
+func (t *LoginThrottlerImpl) isStale(f *loginFailures, now time.Time) bool {
+	return now.Sub(f.lastFailure) > t.cfg.LoginFailureWindow && !f.blockedTill.After(now)
+}
+
+func (t *LoginThrottlerImpl) pruneLocked(now time.Time) {
+	if now.Sub(t.prunedAt) < t.cfg.LoginFailureWindow {
+		return
+	}
+	t.prunedAt = now
+
+	for _, failures := range []map[string]*loginFailures{t.accounts, t.ips} {
+		for key, f := range failures {
+			if t.isStale(f, now) {
+				delete(failures, key)
+			}
+		}
+	}
+}
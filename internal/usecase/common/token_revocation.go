@@ -0,0 +1,241 @@
+package common
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/domain/repository"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TokenRevocationStore keeps track of access tokens that must no longer be
+// accepted, either individually by jti, by session or for a user as a whole.
+type TokenRevocationStore interface {
+	Revoke(ctx context.Context, tokenID string, userID uuid.UUID, expiresAt time.Time) error
+	RevokeAllForUser(ctx context.Context, userID uuid.UUID) error
+	IsRevoked(ctx context.Context, tokenID string, userID uuid.UUID, issuedAt time.Time) (bool, error)
+	RevokeSession(ctx context.Context, sessionID, userID uuid.UUID) error
+	IsSessionRevoked(ctx context.Context, sessionID uuid.UUID) (bool, error)
+}
+
+type cachedValidAfter struct {
+	validAfter *time.Time
+	cachedAt   time.Time
+}
+
+// TokenRevocationStoreImpl is backed by Postgres and keeps an in-process
+// cache in front of it. Revocations are cached until the token expires while
+// negative lookups are only trusted for cacheTTL, so revocations made by
+// other instances are picked up after at most that long.
+type TokenRevocationStoreImpl struct {
+	revokedTokenRepo repository.RevokedTokenRepository
+	userRepo         repository.UserRepository
+	sessionRepo      repository.SessionRepository
+	cacheTTL         time.Duration
+
+	mu              sync.RWMutex
+	revoked         map[string]time.Time
+	notRevoked      map[string]time.Time
+	validAfter      map[uuid.UUID]cachedValidAfter
+	revokedSessions map[uuid.UUID]time.Time
+	activeSessions  map[uuid.UUID]time.Time
+	prunedAt        time.Time
+}
+
+func NewTokenRevocationStore(
+	revokedTokenRepo repository.RevokedTokenRepository,
+	userRepo repository.UserRepository,
+	sessionRepo repository.SessionRepository,
+	cacheTTL time.Duration,
+) TokenRevocationStore {
+	return &TokenRevocationStoreImpl{
+		revokedTokenRepo: revokedTokenRepo,
+		userRepo:         userRepo,
+		sessionRepo:      sessionRepo,
+		cacheTTL:         cacheTTL,
+		revoked:          make(map[string]time.Time),
+		notRevoked:       make(map[string]time.Time),
+		validAfter:       make(map[uuid.UUID]cachedValidAfter),
+		revokedSessions:  make(map[uuid.UUID]time.Time),
+		activeSessions:   make(map[uuid.UUID]time.Time),
+	}
+}
+
+func (s *TokenRevocationStoreImpl) Revoke(ctx context.Context, tokenID string, userID uuid.UUID, expiresAt time.Time) error {
+	if err := s.revokedTokenRepo.Revoke(ctx, tokenID, userID, expiresAt); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.revoked[tokenID] = expiresAt
+	delete(s.notRevoked, tokenID)
+	s.mu.Unlock()
+
+	return nil
+}
+
+func (s *TokenRevocationStoreImpl) RevokeAllForUser(ctx context.Context, userID uuid.UUID) error {
+	// JWT timestamps only carry second precision.
+	now := time.Now().Truncate(time.Second)
+	if err := s.userRepo.SetTokensValidAfter(ctx, userID, now); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.validAfter[userID] = cachedValidAfter{validAfter: &now, cachedAt: time.Now()}
+	s.mu.Unlock()
+
+	return nil
+}
+
+func (s *TokenRevocationStoreImpl) IsRevoked(ctx context.Context, tokenID string, userID uuid.UUID, issuedAt time.Time) (bool, error) {
+	validAfter, err := s.getValidAfter(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	if validAfter != nil && issuedAt.Before(*validAfter) {
+		return true, nil
+	}
+
+	if tokenID == "" {
+		return false, nil
+	}
+
+	now := time.Now()
+	s.mu.RLock()
+	_, revoked := s.revoked[tokenID]
+	checkedAt, checked := s.notRevoked[tokenID]
+	s.mu.RUnlock()
+
+	if revoked {
+		return true, nil
+	}
+	if checked && now.Sub(checkedAt) < s.cacheTTL {
+		return false, nil
+	}
+
+	revoked, err = s.revokedTokenRepo.IsRevoked(ctx, tokenID)
+	if err != nil {
+		return false, err
+	}
+
+	s.mu.Lock()
+	s.pruneLocked(now)
+	if revoked {
+		// The exact expiry is unknown here; keeping it for the TTL is enough
+		// because the next lookup will hit the database again.
+		s.revoked[tokenID] = now.Add(s.cacheTTL)
+	} else {
+		s.notRevoked[tokenID] = now
+	}
+	s.mu.Unlock()
+
+	return revoked, nil
+}
+
+func (s *TokenRevocationStoreImpl) RevokeSession(ctx context.Context, sessionID, userID uuid.UUID) error {
+	if err := s.sessionRepo.Revoke(ctx, sessionID, userID); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.revokedSessions[sessionID] = time.Now()
+	delete(s.activeSessions, sessionID)
+	s.mu.Unlock()
+
+	return nil
+}
+
+// IsSessionRevoked treats unknown sessions as revoked; their rows are only
+// removed together with the account.
+func (s *TokenRevocationStoreImpl) IsSessionRevoked(ctx context.Context, sessionID uuid.UUID) (bool, error) {
+	now := time.Now()
+	s.mu.RLock()
+	_, revoked := s.revokedSessions[sessionID]
+	checkedAt, checked := s.activeSessions[sessionID]
+	s.mu.RUnlock()
+
+	if revoked {
+		return true, nil
+	}
+	if checked && now.Sub(checkedAt) < s.cacheTTL {
+		return false, nil
+	}
+
+	session, err := s.sessionRepo.GetByID(ctx, sessionID)
+	if err != nil && !errors.Is(err, domain.ErrSessionNotFound) {
+		return false, err
+	}
+	revoked = session == nil || session.IsRevoked()
+
+	s.mu.Lock()
+	s.pruneLocked(now)
+	if revoked {
+		s.revokedSessions[sessionID] = now
+	} else {
+		s.activeSessions[sessionID] = now
+	}
+	s.mu.Unlock()
+
+	return revoked, nil
+}
+
+func (s *TokenRevocationStoreImpl) getValidAfter(ctx context.Context, userID uuid.UUID) (*time.Time, error) {
+	now := time.Now()
+	s.mu.RLock()
+	cached, ok := s.validAfter[userID]
+	s.mu.RUnlock()
+
+	if ok && now.Sub(cached.cachedAt) < s.cacheTTL {
+		return cached.validAfter, nil
+	}
+
+	validAfter, err := s.userRepo.GetTokensValidAfter(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.validAfter[userID] = cachedValidAfter{validAfter: validAfter, cachedAt: now}
+	s.mu.Unlock()
+
+	return validAfter, nil
+}
+
+func (s *TokenRevocationStoreImpl) pruneLocked(now time.Time) {
+	if now.Sub(s.prunedAt) < s.cacheTTL {
+		return
+	}
+	s.prunedAt = now
+
+	for tokenID, expiresAt := range s.revoked {
+		if now.After(expiresAt) {
+			delete(s.revoked, tokenID)
+		}
+	}
+	for tokenID, checkedAt := range s.notRevoked {
+		if now.Sub(checkedAt) >= s.cacheTTL {
+			delete(s.notRevoked, tokenID)
+		}
+	}
+	for userID, cached := range s.validAfter {
+		if now.Sub(cached.cachedAt) >= s.cacheTTL {
+			delete(s.validAfter, userID)
+		}
+	}
+	// Revoked sessions are dropped from the cache too; the database still
+	// answers for them on the next lookup.
+	for sessionID, cachedAt := range s.revokedSessions {
+		if now.Sub(cachedAt) >= s.cacheTTL {
+			delete(s.revokedSessions, sessionID)
+		}
+	}
+	for sessionID, checkedAt := range s.activeSessions {
+		if now.Sub(checkedAt) >= s.cacheTTL {
+			delete(s.activeSessions, sessionID)
+		}
+	}
+}
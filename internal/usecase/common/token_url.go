@@ -0,0 +1,17 @@
+package common
+
+import (
+	"net/url"
+	"strings"
+)
+
+// BuildTokenURL appends the token as a query parameter to a frontend URL
+// that may already carry a query string.
+func BuildTokenURL(baseURL, token string) string {
+	separator := "?"
+	if strings.Contains(baseURL, "?") {
+		separator = "&"
+	}
+
+	return baseURL + separator + "token=" + url.QueryEscape(token)
+}
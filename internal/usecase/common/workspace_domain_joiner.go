@@ -0,0 +1,41 @@
+package common
+
+import (
+	"collabotask/internal/domain/entity"
+	"collabotask/internal/domain/repository"
+	"context"
+	"strings"
+	"time"
+)
+
+// WorkspaceDomainJoiner adds users to the workspaces that verified their
+// email domain. Registration, email verification and every kind of login run
+// it, so domains added later still pick up existing accounts.
+type WorkspaceDomainJoiner interface {
+	// JoinByEmailDomain returns how many workspaces the user joined. Users
+	// who have not proven their email are skipped, including legacy accounts
+	// whose verification was backfilled, and each workspace joins a user
+	// at most once, so members who left are not added back.
+	JoinByEmailDomain(ctx context.Context, user *entity.User) (int, error)
+}
+
+type WorkspaceDomainJoinerImpl struct {
+	emailDomainRepo repository.WorkspaceEmailDomainRepository
+}
+
+func NewWorkspaceDomainJoiner(emailDomainRepo repository.WorkspaceEmailDomainRepository) WorkspaceDomainJoiner {
+	return &WorkspaceDomainJoinerImpl{emailDomainRepo: emailDomainRepo}
+}
+
+func (wj *WorkspaceDomainJoinerImpl) JoinByEmailDomain(ctx context.Context, user *entity.User) (int, error) {
+	if user == nil || !user.HasProvenEmail() {
+		return 0, nil
+	}
+
+	_, emailDomain, ok := strings.Cut(strings.TrimSpace(strings.ToLower(user.Email)), "@")
+	if !ok || emailDomain == "" {
+		return 0, nil
+	}
+
+	return wj.emailDomainRepo.JoinUser(ctx, user.ID, emailDomain, time.Now().UTC())
+}
@@ -0,0 +1,92 @@
+package common
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/domain/entity"
+	"collabotask/internal/domain/repository"
+	infraauth "collabotask/internal/infrastructure/auth"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// WorkspaceInvitationAcceptor turns pending workspace invitations into
+// memberships. It is shared by registration, email verification and the
+// explicit accept endpoint.
+type WorkspaceInvitationAcceptor interface {
+	// Lookup returns the pending, unexpired invitation for rawToken if it was
+	// sent to email.
+	Lookup(ctx context.Context, rawToken, email string) (*entity.WorkspaceInvitation, error)
+	// Accept joins user to the workspace of the invitation behind rawToken.
+	Accept(ctx context.Context, user *entity.User, rawToken string) (*entity.WorkspaceInvitation, error)
+	// AcceptPending accepts every pending invitation sent to the user's
+	// email. Callers must only use it once the address is proven.
+	AcceptPending(ctx context.Context, user *entity.User) (int, error)
+}
+
+type WorkspaceInvitationAcceptorImpl struct {
+	invitationRepo repository.WorkspaceInvitationRepository
+}
+
+func NewWorkspaceInvitationAcceptor(invitationRepo repository.WorkspaceInvitationRepository) WorkspaceInvitationAcceptor {
+	return &WorkspaceInvitationAcceptorImpl{invitationRepo: invitationRepo}
+}
+
+func (wa *WorkspaceInvitationAcceptorImpl) Lookup(ctx context.Context, rawToken, email string) (*entity.WorkspaceInvitation, error) {
+	if rawToken == "" {
+		return nil, domain.ErrInvalidInvitation
+	}
+
+	invitation, err := wa.invitationRepo.GetByTokenHash(ctx, infraauth.HashOpaqueToken(rawToken))
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInvitation) {
+			return nil, domain.ErrInvalidInvitation
+		}
+		return nil, fmt.Errorf("failed to fetch invitation: %w", err)
+	}
+	if !invitation.IsPending() || invitation.IsExpired(time.Now().UTC()) {
+		return nil, domain.ErrInvalidInvitation
+	}
+	if invitation.Email != strings.TrimSpace(strings.ToLower(email)) {
+		return nil, domain.ErrInvitationEmailMismatch
+	}
+
+	return invitation, nil
+}
+
+func (wa *WorkspaceInvitationAcceptorImpl) Accept(ctx context.Context, user *entity.User, rawToken string) (*entity.WorkspaceInvitation, error) {
+	invitation, err := wa.Lookup(ctx, rawToken, user.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := wa.invitationRepo.Accept(ctx, invitation.ID, user.ID, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return invitation, nil
+}
+
+func (wa *WorkspaceInvitationAcceptorImpl) AcceptPending(ctx context.Context, user *entity.User) (int, error) {
+	invitations, err := wa.invitationRepo.ListPendingByEmail(ctx, user.Email, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	accepted := 0
+	for _, invitation := range invitations {
+		err := wa.invitationRepo.Accept(ctx, invitation.ID, user.ID, time.Now())
+		if err != nil {
+			// Another request may have accepted it in the meantime.
+			if errors.Is(err, domain.ErrInvalidInvitation) {
+				continue
+			}
+			return accepted, err
+		}
+		accepted++
+	}
+
+	return accepted, nil
+}
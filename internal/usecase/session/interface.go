@@ -0,0 +1,28 @@
+package session
+
+import (
+	"collabotask/internal/dto"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type SessionUseCase interface {
+	ListSessions(ctx context.Context, input ListSessionsInput) (*ListSessionsOutput, error)
+	RevokeSession(ctx context.Context, input RevokeSessionInput) error
+}
+
+type ListSessionsInput struct {
+	UserID uuid.UUID `validate:"required"`
+	// CurrentSessionID marks the session the request was made from, if any.
+	CurrentSessionID uuid.UUID
+}
+
+type ListSessionsOutput struct {
+	Sessions []dto.SessionDTO
+}
+
+type RevokeSessionInput struct {
+	UserID    uuid.UUID `validate:"required"`
+	SessionID uuid.UUID `validate:"required"`
+}
@@ -0,0 +1,30 @@
+package session
+
+import (
+	"collabotask/internal/dto"
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"fmt"
+	"time"
+)
+
+func (su *SessionUseCaseImpl) ListSessions(ctx context.Context, input ListSessionsInput) (*ListSessionsOutput, error) {
+	if err := validator.Struct(input); err != nil {
+		return nil, fmt.Errorf("failed to validate list sessions input: %w", err)
+	}
+
+	// A session unseen for longer than a refresh token lives cannot be
+	// resumed, so it is no longer listed.
+	seenSince := time.Now().UTC().Add(-su.authCfg.RefreshTokenExpiration)
+	sessions, err := su.sessionRepo.ListActiveByUser(ctx, input.UserID, seenSince)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]dto.SessionDTO, 0, len(sessions))
+	for _, session := range sessions {
+		result = append(result, dto.SessionToDTO(session, input.CurrentSessionID))
+	}
+
+	return &ListSessionsOutput{Sessions: result}, nil
+}
@@ -0,0 +1,23 @@
+package session
+
+import (
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"fmt"
+)
+
+func (su *SessionUseCaseImpl) RevokeSession(ctx context.Context, input RevokeSessionInput) error {
+	if err := validator.Struct(input); err != nil {
+		return fmt.Errorf("failed to validate revoke session input: %w", err)
+	}
+
+	if err := su.revocationStore.RevokeSession(ctx, input.SessionID, input.UserID); err != nil {
+		return err
+	}
+
+	if err := su.refreshTokenRepo.RevokeFamily(ctx, input.SessionID); err != nil {
+		return fmt.Errorf("failed to revoke refresh tokens: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package session
+
+import (
+	"collabotask/internal/config"
+	"collabotask/internal/domain/repository"
+	"collabotask/internal/usecase/common"
+)
+
+type SessionUseCaseImpl struct {
+	sessionRepo      repository.SessionRepository
+	refreshTokenRepo repository.RefreshTokenRepository
+	revocationStore  common.TokenRevocationStore
+	authCfg          *config.AuthConfig
+}
+
+func NewSessionUseCase(
+	sessionRepo repository.SessionRepository,
+	refreshTokenRepo repository.RefreshTokenRepository,
+	revocationStore common.TokenRevocationStore,
+	authCfg *config.AuthConfig,
+) SessionUseCase {
+	return &SessionUseCaseImpl{
+		sessionRepo:      sessionRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		revocationStore:  revocationStore,
+		authCfg:          authCfg,
+	}
+}
@@ -0,0 +1,45 @@
+package workspace
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/dto"
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"errors"
+	"fmt"
+)
+
+func (wu *WorkspaceUseCaseImpl) AcceptInvitation(ctx context.Context, input AcceptInvitationInput) (*AcceptInvitationOutput, error) {
+	if err := validator.Struct(input); err != nil {
+		return nil, fmt.Errorf("accept invitation validation failed: %w", err)
+	}
+
+	user, err := wu.userRepo.GetById(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	invitation, err := wu.invitationAcceptor.Accept(ctx, user, input.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	workspace, err := wu.workspaceRepo.GetByID(ctx, invitation.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// An existing membership is kept as it was, so report the actual role.
+	member, err := wu.workspaceMemberRepo.GetByWorkspaceAndUser(ctx, invitation.WorkspaceID, user.ID)
+	if err != nil {
+		if errors.Is(err, domain.ErrMemberNotFound) {
+			return nil, domain.ErrInconsistentState
+		}
+		return nil, fmt.Errorf("failed to fetch membership: %w", err)
+	}
+
+	return &AcceptInvitationOutput{
+		Workspace: dto.WorkspaceToDTO(workspace),
+		Role:      member.Role,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package workspace
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/domain/entity"
+	infraauth "collabotask/internal/infrastructure/auth"
+	"collabotask/internal/infrastructure/domainverify"
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"fmt"
+)
+
+func (wu *WorkspaceUseCaseImpl) AddEmailDomain(ctx context.Context, input AddEmailDomainInput) (*EmailDomainOutput, error) {
+	if err := validator.Struct(input); err != nil {
+		return nil, fmt.Errorf("add email domain validation failed: %w", err)
+	}
+
+	if err := wu.requireAdmin(ctx, input.WorkspaceID, input.RequesterID); err != nil {
+		return nil, err
+	}
+
+	normalized, err := domainverify.NormalizeDomain(input.Domain)
+	if err != nil {
+		return nil, domain.ErrInvalidEmailDomain
+	}
+
+	// The token is published in DNS, so it is stored as is.
+	token, _, err := infraauth.GenerateOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+
+	emailDomain := &entity.WorkspaceEmailDomain{
+		WorkspaceID:       input.WorkspaceID,
+		Domain:            normalized,
+		VerificationToken: token,
+		DefaultBoardIDs:   uniqueUUIDs(input.DefaultBoardIDs),
+		CreatedBy:         &input.RequesterID,
+	}
+	if err := wu.emailDomainRepo.Create(ctx, emailDomain); err != nil {
+		return nil, err
+	}
+
+	return &EmailDomainOutput{
+		EmailDomain: emailDomainToDTO(emailDomain),
+	}, nil
+}
@@ -0,0 +1,28 @@
+package workspace
+
+import (
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"fmt"
+)
+
+func (wu *WorkspaceUseCaseImpl) AddGroupMembers(ctx context.Context, input AddGroupMembersInput) (*GroupOutput, error) {
+	if err := validator.Struct(input); err != nil {
+		return nil, fmt.Errorf("add group members validation failed: %w", err)
+	}
+
+	if err := wu.requireAdmin(ctx, input.WorkspaceID, input.RequesterID); err != nil {
+		return nil, err
+	}
+
+	group, err := wu.groupRepo.GetByID(ctx, input.GroupID, input.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := wu.groupRepo.AddMembers(ctx, group, uniqueUUIDs(input.UserIDs)); err != nil {
+		return nil, err
+	}
+
+	return wu.groupDetail(ctx, group)
+}
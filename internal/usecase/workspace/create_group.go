@@ -0,0 +1,35 @@
+package workspace
+
+import (
+	"collabotask/internal/domain/entity"
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"fmt"
+	"strings"
+)
+
+func (wu *WorkspaceUseCaseImpl) CreateGroup(ctx context.Context, input CreateGroupInput) (*GroupOutput, error) {
+	if err := validator.Struct(input); err != nil {
+		return nil, fmt.Errorf("create group validation failed: %w", err)
+	}
+
+	if err := wu.requireAdmin(ctx, input.WorkspaceID, input.RequesterID); err != nil {
+		return nil, err
+	}
+
+	group := &entity.WorkspaceGroup{
+		WorkspaceID: input.WorkspaceID,
+		Name:        strings.TrimSpace(input.Name),
+		Description: trimmedOrNil(input.Description),
+		CreatedBy:   &input.RequesterID,
+	}
+	if err := validator.Struct(group); err != nil {
+		return nil, fmt.Errorf("create group validation failed: %w", err)
+	}
+
+	if err := wu.groupRepo.Create(ctx, group); err != nil {
+		return nil, err
+	}
+
+	return wu.groupDetail(ctx, group)
+}
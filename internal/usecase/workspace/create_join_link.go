@@ -0,0 +1,59 @@
+package workspace
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/domain/entity"
+	"collabotask/internal/dto"
+	infraauth "collabotask/internal/infrastructure/auth"
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"fmt"
+	"time"
+)
+
+// joinLinkPrefixLen is how much of a code is kept in clear so admins can tell
+// their links apart.
+const joinLinkPrefixLen = 8
+
+func (wu *WorkspaceUseCaseImpl) CreateJoinLink(ctx context.Context, input CreateJoinLinkInput) (*CreateJoinLinkOutput, error) {
+	if err := validator.Struct(input); err != nil {
+		return nil, fmt.Errorf("create join link validation failed: %w", err)
+	}
+
+	requesterMember, err := wu.workspaceMemberRepo.GetByWorkspaceAndUser(ctx, input.WorkspaceID, input.RequesterID)
+	if err != nil || requesterMember == nil || !requesterMember.IsAdmin() {
+		return nil, domain.ErrNotWorkspaceAdmin
+	}
+
+	role := input.Role
+	if role == "" {
+		role = entity.WorkspaceRoleMember
+	}
+
+	code, hash, err := infraauth.GenerateOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+
+	link := &entity.WorkspaceJoinLink{
+		WorkspaceID: input.WorkspaceID,
+		CodePrefix:  code[:joinLinkPrefixLen],
+		CodeHash:    hash,
+		Role:        role,
+		MaxUses:     input.MaxUses,
+		CreatedBy:   &input.RequesterID,
+	}
+	if input.ExpiresInHours != nil {
+		expiresAt := time.Now().UTC().Add(time.Duration(*input.ExpiresInHours) * time.Hour)
+		link.ExpiresAt = &expiresAt
+	}
+
+	if err := wu.joinLinkRepo.Create(ctx, link); err != nil {
+		return nil, err
+	}
+
+	return &CreateJoinLinkOutput{
+		JoinLink: dto.WorkspaceJoinLinkToDTO(link),
+		Code:     code,
+	}, nil
+}
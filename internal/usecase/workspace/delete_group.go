@@ -0,0 +1,19 @@
+package workspace
+
+import (
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"fmt"
+)
+
+func (wu *WorkspaceUseCaseImpl) DeleteGroup(ctx context.Context, input GroupInput) error {
+	if err := validator.Struct(input); err != nil {
+		return fmt.Errorf("delete group validation failed: %w", err)
+	}
+
+	if err := wu.requireAdmin(ctx, input.WorkspaceID, input.RequesterID); err != nil {
+		return err
+	}
+
+	return wu.groupRepo.Delete(ctx, input.GroupID, input.WorkspaceID)
+}
@@ -0,0 +1,37 @@
+package workspace
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"fmt"
+)
+
+func (wu *WorkspaceUseCaseImpl) DeleteWorkspace(ctx context.Context, input DeleteWorkspaceInput) (*DeleteWorkspaceOutput, error) {
+	if err := validator.Struct(input); err != nil {
+		return nil, fmt.Errorf("delete workspace validation failed: %w", err)
+	}
+
+	workspace, err := wu.workspaceRepo.GetByID(ctx, input.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if workspace.OwnerID != input.RequesterID {
+		return nil, domain.ErrNotWorkspaceOwner
+	}
+	if input.ConfirmName != workspace.Name {
+		return nil, domain.ErrWorkspaceNameMismatch
+	}
+
+	summary, err := wu.workspaceRepo.Delete(ctx, input.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeleteWorkspaceOutput{
+		DeletedBoards:  summary.Boards,
+		DeletedColumns: summary.Columns,
+		DeletedCards:   summary.Cards,
+		RemovedMembers: summary.Members,
+	}, nil
+}
@@ -0,0 +1,24 @@
+package workspace
+
+import (
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"fmt"
+)
+
+func (wu *WorkspaceUseCaseImpl) GetGroup(ctx context.Context, input GroupInput) (*GroupOutput, error) {
+	if err := validator.Struct(input); err != nil {
+		return nil, fmt.Errorf("get group validation failed: %w", err)
+	}
+
+	if err := wu.requireDirectoryAccess(ctx, input.WorkspaceID, input.RequesterID); err != nil {
+		return nil, err
+	}
+
+	group, err := wu.groupRepo.GetByID(ctx, input.GroupID, input.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return wu.groupDetail(ctx, group)
+}
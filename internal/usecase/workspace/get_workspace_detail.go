@@ -2,6 +2,7 @@ package workspace
 
 import (
 	"collabotask/internal/domain"
+	"collabotask/internal/domain/entity"
 	"collabotask/internal/dto"
 	"collabotask/internal/infrastructure/validator"
 	"context"
@@ -29,9 +30,13 @@ func (wu *WorkspaceUseCaseImpl) GetWorkspaceDetail(ctx context.Context, input Ge
 		return nil, fmt.Errorf("failed to fetch workspace: %w", err)
 	}
 
-	members, err := wu.workspaceMemberRepo.GetMembersByWorkspace(ctx, input.WorkspaceID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch workspace members: %w", err)
+	// Guests don't get the member directory, only their own membership.
+	members := []*entity.WorkspaceMember{requesterMember}
+	if !requesterMember.IsGuest() {
+		members, err = wu.workspaceMemberRepo.GetMembersByWorkspace(ctx, input.WorkspaceID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch workspace members: %w", err)
+		}
 	}
 
 	userIDs := make([]uuid.UUID, 0, len(members))
@@ -1,6 +1,7 @@
 package workspace
 
 import (
+	"collabotask/internal/domain/entity"
 	"collabotask/internal/dto"
 	"context"
 
@@ -10,9 +11,32 @@ import (
 type WorkspaceUseCase interface {
 	CreateWorkspace(ctx context.Context, input CreateWorkspaceInput) (*CreateWorkspaceOutput, error)
 	GetWorkspaceDetail(ctx context.Context, input GetWorkspaceDetailInput) (*GetWorkspaceDetailOutput, error)
+	ListMembers(ctx context.Context, input ListMembersInput) (*ListMembersOutput, error)
 	InviteMember(ctx context.Context, input InviteMemberInput) (*InviteMemberOutput, error)
 	GetWorkspaces(ctx context.Context, input GetWorkspacesInput) (*GetWorkspacesOutput, error)
 	RemoveMember(ctx context.Context, input RemoveMemberInput) error
+	AcceptInvitation(ctx context.Context, input AcceptInvitationInput) (*AcceptInvitationOutput, error)
+	UpdateMemberRole(ctx context.Context, input UpdateMemberRoleInput) (*UpdateMemberRoleOutput, error)
+	TransferOwnership(ctx context.Context, input TransferOwnershipInput) (*TransferOwnershipOutput, error)
+	UpdateWorkspace(ctx context.Context, input UpdateWorkspaceInput) (*UpdateWorkspaceOutput, error)
+	DeleteWorkspace(ctx context.Context, input DeleteWorkspaceInput) (*DeleteWorkspaceOutput, error)
+	LeaveWorkspace(ctx context.Context, input LeaveWorkspaceInput) (*LeaveWorkspaceOutput, error)
+	CreateJoinLink(ctx context.Context, input CreateJoinLinkInput) (*CreateJoinLinkOutput, error)
+	ListJoinLinks(ctx context.Context, input ListJoinLinksInput) (*ListJoinLinksOutput, error)
+	RevokeJoinLink(ctx context.Context, input RevokeJoinLinkInput) error
+	JoinByLink(ctx context.Context, input JoinByLinkInput) (*JoinByLinkOutput, error)
+	AddEmailDomain(ctx context.Context, input AddEmailDomainInput) (*EmailDomainOutput, error)
+	ListEmailDomains(ctx context.Context, input ListEmailDomainsInput) (*ListEmailDomainsOutput, error)
+	VerifyEmailDomain(ctx context.Context, input EmailDomainInput) (*EmailDomainOutput, error)
+	SetEmailDomainBoards(ctx context.Context, input SetEmailDomainBoardsInput) (*EmailDomainOutput, error)
+	RemoveEmailDomain(ctx context.Context, input EmailDomainInput) error
+	CreateGroup(ctx context.Context, input CreateGroupInput) (*GroupOutput, error)
+	ListGroups(ctx context.Context, input ListGroupsInput) (*ListGroupsOutput, error)
+	GetGroup(ctx context.Context, input GroupInput) (*GroupOutput, error)
+	UpdateGroup(ctx context.Context, input UpdateGroupInput) (*GroupOutput, error)
+	DeleteGroup(ctx context.Context, input GroupInput) error
+	AddGroupMembers(ctx context.Context, input AddGroupMembersInput) (*GroupOutput, error)
+	RemoveGroupMember(ctx context.Context, input RemoveGroupMemberInput) error
 }
 
 type CreateWorkspaceInput struct {
@@ -34,14 +58,68 @@ type GetWorkspaceDetailOutput struct {
 	Workspace dto.WorkspaceDetailDTO
 }
 
+type ListMembersInput struct {
+	RequesterID uuid.UUID            `validate:"required"`
+	WorkspaceID uuid.UUID            `validate:"required"`
+	Search      string               `validate:"max=255"`
+	Role        entity.WorkspaceRole `validate:"omitempty,oneof=ADMIN MEMBER GUEST"`
+	// Sort defaults to name and Order to asc.
+	Sort  string `validate:"omitempty,oneof=name email joined_at"`
+	Order string `validate:"omitempty,oneof=asc desc"`
+	// Cursor is the NextCursor of the previous page; it is only valid with
+	// the same sort and order.
+	Cursor string `validate:"max=512"`
+	Limit  int    `validate:"min=0,max=100"`
+}
+
+type ListMembersOutput struct {
+	Members []dto.WorkspaceMemberDTO
+	// NextCursor is empty on the last page.
+	NextCursor string
+}
+
 type InviteMemberInput struct {
 	RequesterID uuid.UUID `validate:"required"`
 	WorkspaceID uuid.UUID `validate:"required"`
-	Emails      []string  `validate:"required,min=1,dive,email"`
+	// Emails are checked one by one; malformed addresses are reported as
+	// InviteStatusInvalid instead of failing the whole request.
+	Emails []string `validate:"required,min=1,max=100"`
+	// Role is granted to added members and invitees; it defaults to MEMBER.
+	Role entity.WorkspaceRole `validate:"omitempty,oneof=ADMIN MEMBER GUEST"`
+}
+
+type InviteStatus string
+
+const (
+	InviteStatusAdded         InviteStatus = "ADDED"
+	InviteStatusInvited       InviteStatus = "INVITED"
+	InviteStatusAlreadyMember InviteStatus = "ALREADY_MEMBER"
+	InviteStatusUnverified    InviteStatus = "UNVERIFIED"
+	InviteStatusInvalid       InviteStatus = "INVALID"
+	InviteStatusFailed        InviteStatus = "FAILED"
+)
+
+// InviteResult is the outcome for one requested email. Emails without an
+// account are unknown to the workspace and receive an invitation
+// (InviteStatusInvited); FAILED means that invitation could not be sent.
+type InviteResult struct {
+	Email  string
+	Status InviteStatus
 }
 
 type InviteMemberOutput struct {
 	Message string
+	Results []InviteResult
+}
+
+// AllSucceeded reports whether every email was added or invited.
+func (o *InviteMemberOutput) AllSucceeded() bool {
+	for _, r := range o.Results {
+		if r.Status != InviteStatusAdded && r.Status != InviteStatusInvited {
+			return false
+		}
+	}
+	return true
 }
 
 type GetWorkspacesInput struct {
@@ -52,8 +130,205 @@ type GetWorkspacesOutput struct {
 	Workspaces []dto.WorkspaceWithMetaDTO
 }
 
+type AcceptInvitationInput struct {
+	UserID uuid.UUID `validate:"required"`
+	Token  string    `validate:"required"`
+}
+
+type AcceptInvitationOutput struct {
+	Workspace dto.WorkspaceDTO
+	Role      entity.WorkspaceRole
+}
+
+type CreateJoinLinkInput struct {
+	RequesterID uuid.UUID `validate:"required"`
+	WorkspaceID uuid.UUID `validate:"required"`
+	// Role is granted to everyone joining through the link; it defaults to
+	// MEMBER.
+	Role entity.WorkspaceRole `validate:"omitempty,oneof=ADMIN MEMBER GUEST"`
+	// MaxUses and ExpiresInHours are unlimited when nil.
+	MaxUses        *int `validate:"omitempty,min=1,max=10000"`
+	ExpiresInHours *int `validate:"omitempty,min=1,max=720"`
+}
+
+type CreateJoinLinkOutput struct {
+	JoinLink dto.WorkspaceJoinLinkDTO
+	// Code is only ever returned here; just its hash is stored.
+	Code string
+}
+
+type ListJoinLinksInput struct {
+	RequesterID uuid.UUID `validate:"required"`
+	WorkspaceID uuid.UUID `validate:"required"`
+}
+
+type ListJoinLinksOutput struct {
+	JoinLinks []dto.WorkspaceJoinLinkDTO
+}
+
+type RevokeJoinLinkInput struct {
+	RequesterID uuid.UUID `validate:"required"`
+	WorkspaceID uuid.UUID `validate:"required"`
+	LinkID      uuid.UUID `validate:"required"`
+}
+
+type JoinByLinkInput struct {
+	UserID uuid.UUID `validate:"required"`
+	Code   string    `validate:"required"`
+}
+
+type JoinByLinkOutput struct {
+	Workspace dto.WorkspaceDTO
+	Role      entity.WorkspaceRole
+}
+
+type AddEmailDomainInput struct {
+	RequesterID uuid.UUID `validate:"required"`
+	WorkspaceID uuid.UUID `validate:"required"`
+	Domain      string    `validate:"required,max=253"`
+	// DefaultBoardIDs are boards users joining through the domain are added
+	// to; they must belong to the workspace.
+	DefaultBoardIDs []uuid.UUID `validate:"omitempty,max=50"`
+}
+
+type ListEmailDomainsInput struct {
+	RequesterID uuid.UUID `validate:"required"`
+	WorkspaceID uuid.UUID `validate:"required"`
+}
+
+type ListEmailDomainsOutput struct {
+	EmailDomains []dto.WorkspaceEmailDomainDTO
+}
+
+type EmailDomainInput struct {
+	RequesterID uuid.UUID `validate:"required"`
+	WorkspaceID uuid.UUID `validate:"required"`
+	DomainID    uuid.UUID `validate:"required"`
+}
+
+type SetEmailDomainBoardsInput struct {
+	RequesterID     uuid.UUID   `validate:"required"`
+	WorkspaceID     uuid.UUID   `validate:"required"`
+	DomainID        uuid.UUID   `validate:"required"`
+	DefaultBoardIDs []uuid.UUID `validate:"max=50"`
+}
+
+type EmailDomainOutput struct {
+	EmailDomain dto.WorkspaceEmailDomainDTO
+}
+
 type RemoveMemberInput struct {
 	RequesterID uuid.UUID `validate:"required"`
 	WorkspaceID uuid.UUID `validate:"required"`
 	UserID      uuid.UUID `validate:"required"`
 }
+
+type LeaveWorkspaceInput struct {
+	UserID      uuid.UUID `validate:"required"`
+	WorkspaceID uuid.UUID `validate:"required"`
+	// UnassignCards clears the user's card assignments in the workspace;
+	// otherwise they stay assigned.
+	UnassignCards bool
+}
+
+type LeaveWorkspaceOutput struct {
+	RemovedBoardMemberships int
+	TransferredBoards       int
+	UnassignedCards         int
+}
+
+type UpdateMemberRoleInput struct {
+	RequesterID uuid.UUID            `validate:"required"`
+	WorkspaceID uuid.UUID            `validate:"required"`
+	UserID      uuid.UUID            `validate:"required"`
+	Role        entity.WorkspaceRole `validate:"required,oneof=ADMIN MEMBER GUEST"`
+}
+
+type UpdateMemberRoleOutput struct {
+	Member dto.WorkspaceMemberDTO
+}
+
+type TransferOwnershipInput struct {
+	RequesterID uuid.UUID `validate:"required"`
+	WorkspaceID uuid.UUID `validate:"required"`
+	NewOwnerID  uuid.UUID `validate:"required"`
+}
+
+type TransferOwnershipOutput struct {
+	Workspace dto.WorkspaceDTO
+}
+
+type UpdateWorkspaceInput struct {
+	RequesterID        uuid.UUID `validate:"required"`
+	WorkspaceID        uuid.UUID `validate:"required"`
+	Name               *string   `validate:"omitempty,min=2,max=255"`
+	Description        *string   `validate:"omitempty,max=1000"`
+	DescriptionPresent bool
+}
+
+type UpdateWorkspaceOutput struct {
+	Workspace dto.WorkspaceDTO
+}
+
+type DeleteWorkspaceInput struct {
+	RequesterID uuid.UUID `validate:"required"`
+	WorkspaceID uuid.UUID `validate:"required"`
+	// ConfirmName must repeat the workspace name exactly.
+	ConfirmName string `validate:"required"`
+}
+
+type DeleteWorkspaceOutput struct {
+	DeletedBoards  int
+	DeletedColumns int
+	DeletedCards   int
+	RemovedMembers int
+}
+
+type CreateGroupInput struct {
+	RequesterID uuid.UUID `validate:"required"`
+	WorkspaceID uuid.UUID `validate:"required"`
+	Name        string    `validate:"required,min=1,max=100"`
+	Description *string   `validate:"omitempty,max=1000"`
+}
+
+type ListGroupsInput struct {
+	RequesterID uuid.UUID `validate:"required"`
+	WorkspaceID uuid.UUID `validate:"required"`
+}
+
+type ListGroupsOutput struct {
+	Groups []dto.WorkspaceGroupDTO
+}
+
+type GroupInput struct {
+	RequesterID uuid.UUID `validate:"required"`
+	WorkspaceID uuid.UUID `validate:"required"`
+	GroupID     uuid.UUID `validate:"required"`
+}
+
+type UpdateGroupInput struct {
+	RequesterID        uuid.UUID `validate:"required"`
+	WorkspaceID        uuid.UUID `validate:"required"`
+	GroupID            uuid.UUID `validate:"required"`
+	Name               *string   `validate:"omitempty,min=1,max=100"`
+	Description        *string   `validate:"omitempty,max=1000"`
+	DescriptionPresent bool
+}
+
+type AddGroupMembersInput struct {
+	RequesterID uuid.UUID   `validate:"required"`
+	WorkspaceID uuid.UUID   `validate:"required"`
+	GroupID     uuid.UUID   `validate:"required"`
+	UserIDs     []uuid.UUID `validate:"required,min=1,max=100"`
+}
+
+type RemoveGroupMemberInput struct {
+	RequesterID uuid.UUID `validate:"required"`
+	WorkspaceID uuid.UUID `validate:"required"`
+	GroupID     uuid.UUID `validate:"required"`
+	UserID      uuid.UUID `validate:"required"`
+}
+
+type GroupOutput struct {
+	Group dto.WorkspaceGroupDetailDTO
+}
@@ -3,10 +3,17 @@ package workspace
 import (
 	"collabotask/internal/domain"
 	"collabotask/internal/domain/entity"
+	infraauth "collabotask/internal/infrastructure/auth"
+	"collabotask/internal/infrastructure/mailer"
 	"collabotask/internal/infrastructure/validator"
+	"collabotask/internal/usecase/common"
 	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
+
+	"github.com/google/uuid"
 )
 
 func (wu *WorkspaceUseCaseImpl) InviteMember(ctx context.Context, input InviteMemberInput) (*InviteMemberOutput, error) {
@@ -19,36 +26,142 @@ func (wu *WorkspaceUseCaseImpl) InviteMember(ctx context.Context, input InviteMe
 		return nil, domain.ErrNotWorkspaceAdmin
 	}
 
+	role := input.Role
+	if role == "" {
+		role = entity.WorkspaceRoleMember
+	}
+
+	results := make([]InviteResult, 0, len(input.Emails))
+	members := make([]*entity.WorkspaceMember, 0, len(input.Emails))
+	memberEmails := make(map[uuid.UUID]string, len(input.Emails))
+	var unknownEmails []string
+	seen := make(map[string]struct{}, len(input.Emails))
+
+	// Every email is classified before anything is written, so a lookup
+	// failure leaves the workspace untouched.
 	for _, email := range input.Emails {
 		trimmedEmail := strings.TrimSpace(strings.ToLower(email))
-		if trimmedEmail == "" {
+		if _, ok := seen[trimmedEmail]; ok {
 			continue
 		}
+		seen[trimmedEmail] = struct{}{}
 
-		user, err := wu.userRepo.GetByEmail(ctx, trimmedEmail)
-		if err != nil || user == nil {
-			return nil, domain.ErrUserNotFound
+		if trimmedEmail == "" || validator.Var(trimmedEmail, "email") != nil {
+			results = append(results, InviteResult{Email: trimmedEmail, Status: InviteStatusInvalid})
+			continue
 		}
 
-		existsInWorkspace, err := wu.workspaceMemberRepo.IsUserExists(ctx, input.WorkspaceID, user.ID)
+		user, err := wu.userRepo.GetByEmail(ctx, trimmedEmail)
+		if errors.Is(err, domain.ErrUserNotFound) {
+			unknownEmails = append(unknownEmails, trimmedEmail)
+			continue
+		}
 		if err != nil {
-			return nil, fmt.Errorf("failed to check member existence: %w", err)
+			return nil, fmt.Errorf("failed to fetch user: %w", err)
 		}
-		if existsInWorkspace {
-			return nil, domain.ErrAlreadyMember
+		if wu.authCfg.RequireVerifiedEmailForInvite && !user.IsEmailVerified() {
+			results = append(results, InviteResult{Email: trimmedEmail, Status: InviteStatusUnverified})
+			continue
 		}
 
-		member := &entity.WorkspaceMember{
+		members = append(members, &entity.WorkspaceMember{
 			WorkspaceID: input.WorkspaceID,
 			UserID:      user.ID,
-			Role:        entity.WorkspaceRoleMember,
+			Role:        role,
+		})
+		memberEmails[user.ID] = trimmedEmail
+	}
+
+	// Existing members are skipped by the insert itself, so a concurrent
+	// join is reported as ALREADY_MEMBER instead of failing the batch.
+	added, err := wu.workspaceMemberRepo.CreateMany(ctx, members)
+	if err != nil {
+		return nil, err
+	}
+	addedIDs := make(map[uuid.UUID]struct{}, len(added))
+	for _, member := range added {
+		addedIDs[member.UserID] = struct{}{}
+	}
+	for _, member := range members {
+		status := InviteStatusAlreadyMember
+		if _, ok := addedIDs[member.UserID]; ok {
+			status = InviteStatusAdded
+		}
+		results = append(results, InviteResult{Email: memberEmails[member.UserID], Status: status})
+	}
+
+	if len(unknownEmails) > 0 {
+		workspace, err := wu.workspaceRepo.GetByID(ctx, input.WorkspaceID)
+		if err != nil {
+			return nil, err
+		}
+		inviter, err := wu.userRepo.GetById(ctx, input.RequesterID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch inviter: %w", err)
 		}
-		if err := wu.workspaceMemberRepo.Create(ctx, member); err != nil {
-			return nil, fmt.Errorf("failed to add member to workspace: %w", err)
+
+		// Each invitation stands alone; one that cannot be sent is reported
+		// without undoing the members added above.
+		for _, email := range unknownEmails {
+			status := InviteStatusInvited
+			if err := wu.inviteByEmail(ctx, workspace, inviter, email, role); err != nil {
+				status = InviteStatusFailed
+			}
+			results = append(results, InviteResult{Email: email, Status: status})
 		}
 	}
 
-	return &InviteMemberOutput{
-		Message: "Users have been added to the workspace",
-	}, nil
+	output := &InviteMemberOutput{Results: results}
+	if output.AllSucceeded() {
+		output.Message = "All invitations processed successfully"
+	} else {
+		output.Message = "Some invitations could not be processed"
+	}
+
+	return output, nil
+}
+
+// inviteByEmail records a pending invitation for an address without an
+// account and mails its token. Inviting the same address again refreshes the
+// pending invitation, which makes a lost email easy to resend.
+func (wu *WorkspaceUseCaseImpl) inviteByEmail(
+	ctx context.Context,
+	workspace *entity.Workspace,
+	inviter *entity.User,
+	email string,
+	role entity.WorkspaceRole,
+) error {
+	rawToken, hash, err := infraauth.GenerateOpaqueToken()
+	if err != nil {
+		return err
+	}
+
+	invitation := &entity.WorkspaceInvitation{
+		WorkspaceID: workspace.ID,
+		Email:       email,
+		Role:        role,
+		TokenHash:   hash,
+		InvitedBy:   &inviter.ID,
+		ExpiresAt:   time.Now().UTC().Add(wu.authCfg.WorkspaceInvitationTTL),
+	}
+	if err := wu.invitationRepo.Upsert(ctx, invitation); err != nil {
+		return err
+	}
+
+	err = wu.mailer.Send(ctx, mailer.Message{
+		To:      email,
+		Subject: fmt.Sprintf("%s invited you to %s on Collabotask", inviter.Name, workspace.Name),
+		Body: fmt.Sprintf(
+			"Hi,\n\n%s invited you to join the workspace \"%s\" on Collabotask.\n\nCreate your account or sign in through the link below to accept:\n\n%s\n\nThe invitation expires in %s.",
+			inviter.Name,
+			workspace.Name,
+			common.BuildTokenURL(wu.authCfg.WorkspaceInvitationURL, rawToken),
+			wu.authCfg.WorkspaceInvitationTTL,
+		),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send invitation email: %w", err)
+	}
+
+	return nil
 }
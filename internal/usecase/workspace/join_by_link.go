@@ -0,0 +1,42 @@
+package workspace
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/dto"
+	infraauth "collabotask/internal/infrastructure/auth"
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"fmt"
+	"time"
+)
+
+func (wu *WorkspaceUseCaseImpl) JoinByLink(ctx context.Context, input JoinByLinkInput) (*JoinByLinkOutput, error) {
+	if err := validator.Struct(input); err != nil {
+		return nil, fmt.Errorf("join by link validation failed: %w", err)
+	}
+
+	user, err := wu.userRepo.GetById(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	// A join link is an open invitation, so it follows the same rule as
+	// inviting an existing user.
+	if wu.authCfg.RequireVerifiedEmailForInvite && !user.IsEmailVerified() {
+		return nil, domain.ErrEmailNotVerified
+	}
+
+	member, err := wu.joinLinkRepo.Redeem(ctx, infraauth.HashOpaqueToken(input.Code), user.ID, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	workspace, err := wu.workspaceRepo.GetByID(ctx, member.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &JoinByLinkOutput{
+		Workspace: dto.WorkspaceToDTO(workspace),
+		Role:      member.Role,
+	}, nil
+}
@@ -0,0 +1,34 @@
+package workspace
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"fmt"
+)
+
+func (wu *WorkspaceUseCaseImpl) LeaveWorkspace(ctx context.Context, input LeaveWorkspaceInput) (*LeaveWorkspaceOutput, error) {
+	if err := validator.Struct(input); err != nil {
+		return nil, fmt.Errorf("leave workspace validation failed: %w", err)
+	}
+
+	workspace, err := wu.workspaceRepo.GetByID(ctx, input.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if workspace.OwnerID == input.UserID {
+		return nil, domain.ErrOwnerCannotLeave
+	}
+
+	// The repository re-checks ownership under a lock.
+	summary, err := wu.workspaceMemberRepo.Leave(ctx, input.WorkspaceID, input.UserID, input.UnassignCards)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LeaveWorkspaceOutput{
+		RemovedBoardMemberships: summary.RemovedBoardMemberships,
+		TransferredBoards:       summary.TransferredBoards,
+		UnassignedCards:         summary.UnassignedCards,
+	}, nil
+}
@@ -0,0 +1,32 @@
+package workspace
+
+import (
+	"collabotask/internal/dto"
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"fmt"
+)
+
+func (wu *WorkspaceUseCaseImpl) ListEmailDomains(ctx context.Context, input ListEmailDomainsInput) (*ListEmailDomainsOutput, error) {
+	if err := validator.Struct(input); err != nil {
+		return nil, fmt.Errorf("list email domains validation failed: %w", err)
+	}
+
+	if err := wu.requireAdmin(ctx, input.WorkspaceID, input.RequesterID); err != nil {
+		return nil, err
+	}
+
+	domains, err := wu.emailDomainRepo.ListByWorkspace(ctx, input.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ListEmailDomainsOutput{
+		EmailDomains: make([]dto.WorkspaceEmailDomainDTO, 0, len(domains)),
+	}
+	for _, d := range domains {
+		output.EmailDomains = append(output.EmailDomains, emailDomainToDTO(d))
+	}
+
+	return output, nil
+}
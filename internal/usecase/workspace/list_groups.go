@@ -0,0 +1,32 @@
+package workspace
+
+import (
+	"collabotask/internal/dto"
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"fmt"
+)
+
+func (wu *WorkspaceUseCaseImpl) ListGroups(ctx context.Context, input ListGroupsInput) (*ListGroupsOutput, error) {
+	if err := validator.Struct(input); err != nil {
+		return nil, fmt.Errorf("list groups validation failed: %w", err)
+	}
+
+	if err := wu.requireDirectoryAccess(ctx, input.WorkspaceID, input.RequesterID); err != nil {
+		return nil, err
+	}
+
+	groups, err := wu.groupRepo.ListByWorkspace(ctx, input.WorkspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list groups: %w", err)
+	}
+
+	result := make([]dto.WorkspaceGroupDTO, 0, len(groups))
+	for _, group := range groups {
+		result = append(result, dto.WorkspaceGroupToDTO(&group.WorkspaceGroup, group.MemberCount))
+	}
+
+	return &ListGroupsOutput{
+		Groups: result,
+	}, nil
+}
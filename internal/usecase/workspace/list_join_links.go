@@ -0,0 +1,35 @@
+package workspace
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/dto"
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"fmt"
+	"time"
+)
+
+func (wu *WorkspaceUseCaseImpl) ListJoinLinks(ctx context.Context, input ListJoinLinksInput) (*ListJoinLinksOutput, error) {
+	if err := validator.Struct(input); err != nil {
+		return nil, fmt.Errorf("list join links validation failed: %w", err)
+	}
+
+	requesterMember, err := wu.workspaceMemberRepo.GetByWorkspaceAndUser(ctx, input.WorkspaceID, input.RequesterID)
+	if err != nil || requesterMember == nil || !requesterMember.IsAdmin() {
+		return nil, domain.ErrNotWorkspaceAdmin
+	}
+
+	links, err := wu.joinLinkRepo.ListActiveByWorkspace(ctx, input.WorkspaceID, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ListJoinLinksOutput{
+		JoinLinks: make([]dto.WorkspaceJoinLinkDTO, 0, len(links)),
+	}
+	for _, link := range links {
+		output.JoinLinks = append(output.JoinLinks, dto.WorkspaceJoinLinkToDTO(link))
+	}
+
+	return output, nil
+}
@@ -0,0 +1,148 @@
+package workspace
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/domain/entity"
+	"collabotask/internal/domain/repository"
+	"collabotask/internal/dto"
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const defaultMemberPageLimit = 20
+
+// memberCursor is the last member of a page, encoded as opaque base64 JSON.
+// Sort and Desc are kept so a cursor cannot be replayed with another order.
+type memberCursor struct {
+	Sort   repository.WorkspaceMemberSort `json:"s"`
+	Desc   bool                           `json:"d"`
+	Value  string                         `json:"v"`
+	UserID uuid.UUID                      `json:"u"`
+}
+
+func (wu *WorkspaceUseCaseImpl) ListMembers(ctx context.Context, input ListMembersInput) (*ListMembersOutput, error) {
+	if err := validator.Struct(input); err != nil {
+		return nil, fmt.Errorf("list members validation failed: %w", err)
+	}
+
+	requesterMember, err := wu.workspaceMemberRepo.GetByWorkspaceAndUser(ctx, input.WorkspaceID, input.RequesterID)
+	if err != nil || requesterMember == nil || requesterMember.IsEmpty() {
+		return nil, domain.ErrUserNotInWorkspace
+	}
+	if requesterMember.IsGuest() {
+		return nil, domain.ErrWorkspaceGuest
+	}
+
+	filter := repository.WorkspaceMemberFilter{
+		Search:     input.Search,
+		Role:       input.Role,
+		Sort:       repository.WorkspaceMemberSortName,
+		Descending: input.Order == "desc",
+		Limit:      input.Limit,
+	}
+	if input.Sort != "" {
+		filter.Sort = repository.WorkspaceMemberSort(input.Sort)
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultMemberPageLimit
+	}
+
+	if input.Cursor != "" {
+		cursor, err := decodeMemberCursor(input.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		if cursor.Sort != filter.Sort || cursor.Desc != filter.Descending {
+			return nil, domain.ErrInvalidMemberCursor
+		}
+		filter.AfterValue = cursor.Value
+		filter.AfterUserID = cursor.UserID
+	}
+
+	// One extra row tells whether another page follows.
+	pageLimit := filter.Limit
+	filter.Limit++
+
+	items, err := wu.workspaceMemberRepo.ListMembers(ctx, input.WorkspaceID, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workspace members: %w", err)
+	}
+
+	var nextCursor string
+	if len(items) > pageLimit {
+		items = items[:pageLimit]
+		last := items[len(items)-1]
+		nextCursor, err = encodeMemberCursor(memberCursor{
+			Sort:   filter.Sort,
+			Desc:   filter.Descending,
+			Value:  memberSortValue(last, filter.Sort),
+			UserID: last.UserID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode member cursor: %w", err)
+		}
+	}
+
+	members := make([]dto.WorkspaceMemberDTO, 0, len(items))
+	for _, item := range items {
+		members = append(members, dto.WorkspaceMemberListItemToDTO(item))
+	}
+
+	return &ListMembersOutput{
+		Members:    members,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func memberSortValue(item *entity.WorkspaceMemberListItem, sort repository.WorkspaceMemberSort) string {
+	switch sort {
+	case repository.WorkspaceMemberSortEmail:
+		return item.Email
+	case repository.WorkspaceMemberSortJoinedAt:
+		return item.JoinedAt.Format(time.RFC3339Nano)
+	default:
+		return item.Name
+	}
+}
+
+func encodeMemberCursor(cursor memberCursor) (string, error) {
+	raw, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// decodeMemberCursor returns ErrInvalidMemberCursor unless the value parses
+// for the cursor's sort key, so a tampered cursor never reaches the query.
+func decodeMemberCursor(s string) (memberCursor, error) {
+	var cursor memberCursor
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return cursor, domain.ErrInvalidMemberCursor
+	}
+	if err := json.Unmarshal(raw, &cursor); err != nil {
+		return cursor, domain.ErrInvalidMemberCursor
+	}
+	if cursor.UserID == uuid.Nil {
+		return cursor, domain.ErrInvalidMemberCursor
+	}
+
+	switch cursor.Sort {
+	case repository.WorkspaceMemberSortName, repository.WorkspaceMemberSortEmail:
+	case repository.WorkspaceMemberSortJoinedAt:
+		if _, err := time.Parse(time.RFC3339Nano, cursor.Value); err != nil {
+			return cursor, domain.ErrInvalidMemberCursor
+		}
+	default:
+		return cursor, domain.ErrInvalidMemberCursor
+	}
+
+	return cursor, nil
+}
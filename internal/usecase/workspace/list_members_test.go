@@ -0,0 +1,68 @@
+package workspace
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/domain/repository"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestMemberCursorRoundTrip(t *testing.T) {
+	want := memberCursor{
+		Sort:   repository.WorkspaceMemberSortJoinedAt,
+		Desc:   true,
+		Value:  time.Date(2026, 1, 2, 3, 4, 5, 6, time.UTC).Format(time.RFC3339Nano),
+		UserID: uuid.New(),
+	}
+
+	encoded, err := encodeMemberCursor(want)
+	if err != nil {
+		t.Fatalf("Failed to encode cursor: %v", err)
+	}
+
+	got, err := decodeMemberCursor(encoded)
+	if err != nil {
+		t.Fatalf("Failed to decode cursor: %v", err)
+	}
+	if got != want {
+		t.Errorf("Expected cursor %+v, got %+v", want, got)
+	}
+}
+
+func TestDecodeMemberCursorRejectsInvalidValues(t *testing.T) {
+	userID := uuid.New()
+
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{"not base64", "%%%"},
+		{"not json", base64.RawURLEncoding.EncodeToString([]byte("nope"))},
+		{"joined_at not a timestamp", mustEncodeMemberCursor(t, memberCursor{Sort: repository.WorkspaceMemberSortJoinedAt, Value: "yesterday", UserID: userID})},
+		{"joined_at empty", mustEncodeMemberCursor(t, memberCursor{Sort: repository.WorkspaceMemberSortJoinedAt, UserID: userID})},
+		{"unknown sort", mustEncodeMemberCursor(t, memberCursor{Sort: "role", Value: "ADMIN", UserID: userID})},
+		{"missing user", mustEncodeMemberCursor(t, memberCursor{Sort: repository.WorkspaceMemberSortName, Value: "Ann"})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := decodeMemberCursor(tt.cursor); !errors.Is(err, domain.ErrInvalidMemberCursor) {
+				t.Errorf("Expected ErrInvalidMemberCursor, got %v", err)
+			}
+		})
+	}
+}
+
+func mustEncodeMemberCursor(t *testing.T, cursor memberCursor) string {
+	t.Helper()
+
+	encoded, err := encodeMemberCursor(cursor)
+	if err != nil {
+		t.Fatalf("Failed to encode cursor: %v", err)
+	}
+	return encoded
+}
@@ -0,0 +1,21 @@
+package workspace
+
+import (
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"fmt"
+)
+
+// RemoveEmailDomain stops future auto-joins; members who already joined
+// through the domain stay.
+func (wu *WorkspaceUseCaseImpl) RemoveEmailDomain(ctx context.Context, input EmailDomainInput) error {
+	if err := validator.Struct(input); err != nil {
+		return fmt.Errorf("remove email domain validation failed: %w", err)
+	}
+
+	if err := wu.requireAdmin(ctx, input.WorkspaceID, input.RequesterID); err != nil {
+		return err
+	}
+
+	return wu.emailDomainRepo.Delete(ctx, input.DomainID, input.WorkspaceID)
+}
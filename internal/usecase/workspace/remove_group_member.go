@@ -0,0 +1,24 @@
+package workspace
+
+import (
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"fmt"
+)
+
+func (wu *WorkspaceUseCaseImpl) RemoveGroupMember(ctx context.Context, input RemoveGroupMemberInput) error {
+	if err := validator.Struct(input); err != nil {
+		return fmt.Errorf("remove group member validation failed: %w", err)
+	}
+
+	if err := wu.requireAdmin(ctx, input.WorkspaceID, input.RequesterID); err != nil {
+		return err
+	}
+
+	group, err := wu.groupRepo.GetByID(ctx, input.GroupID, input.WorkspaceID)
+	if err != nil {
+		return err
+	}
+
+	return wu.groupRepo.RemoveMember(ctx, group.ID, input.UserID)
+}
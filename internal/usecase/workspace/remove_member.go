@@ -21,6 +21,14 @@ func (wu *WorkspaceUseCaseImpl) RemoveMember(ctx context.Context, input RemoveMe
 		return domain.ErrCannotRemoveYourself
 	}
 
+	workspace, err := wu.workspaceRepo.GetByID(ctx, input.WorkspaceID)
+	if err != nil {
+		return err
+	}
+	if workspace.OwnerID == input.UserID {
+		return domain.ErrCannotRemoveOwner
+	}
+
 	err = wu.workspaceMemberRepo.Delete(ctx, input.WorkspaceID, input.UserID)
 	if err != nil {
 		if errors.Is(err, domain.ErrMemberNotFound) {
@@ -0,0 +1,21 @@
+package workspace
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"fmt"
+)
+
+func (wu *WorkspaceUseCaseImpl) RevokeJoinLink(ctx context.Context, input RevokeJoinLinkInput) error {
+	if err := validator.Struct(input); err != nil {
+		return fmt.Errorf("revoke join link validation failed: %w", err)
+	}
+
+	requesterMember, err := wu.workspaceMemberRepo.GetByWorkspaceAndUser(ctx, input.WorkspaceID, input.RequesterID)
+	if err != nil || requesterMember == nil || !requesterMember.IsAdmin() {
+		return domain.ErrNotWorkspaceAdmin
+	}
+
+	return wu.joinLinkRepo.Revoke(ctx, input.LinkID, input.WorkspaceID)
+}
@@ -0,0 +1,32 @@
+package workspace
+
+import (
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"fmt"
+)
+
+func (wu *WorkspaceUseCaseImpl) SetEmailDomainBoards(ctx context.Context, input SetEmailDomainBoardsInput) (*EmailDomainOutput, error) {
+	if err := validator.Struct(input); err != nil {
+		return nil, fmt.Errorf("set email domain boards validation failed: %w", err)
+	}
+
+	if err := wu.requireAdmin(ctx, input.WorkspaceID, input.RequesterID); err != nil {
+		return nil, err
+	}
+
+	// Only users joining from now on get the new boards.
+	err := wu.emailDomainRepo.SetDefaultBoards(ctx, input.DomainID, input.WorkspaceID, uniqueUUIDs(input.DefaultBoardIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	emailDomain, err := wu.emailDomainRepo.GetByID(ctx, input.DomainID, input.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EmailDomainOutput{
+		EmailDomain: emailDomainToDTO(emailDomain),
+	}, nil
+}
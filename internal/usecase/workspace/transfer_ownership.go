@@ -0,0 +1,37 @@
+package workspace
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/dto"
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"fmt"
+)
+
+func (wu *WorkspaceUseCaseImpl) TransferOwnership(ctx context.Context, input TransferOwnershipInput) (*TransferOwnershipOutput, error) {
+	if err := validator.Struct(input); err != nil {
+		return nil, fmt.Errorf("transfer ownership validation failed: %w", err)
+	}
+
+	workspace, err := wu.workspaceRepo.GetByID(ctx, input.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if workspace.OwnerID != input.RequesterID {
+		return nil, domain.ErrNotWorkspaceOwner
+	}
+	if input.NewOwnerID == input.RequesterID {
+		return nil, domain.ErrAlreadyWorkspaceOwner
+	}
+
+	// The new owner is promoted to admin if needed; the previous owner keeps
+	// the admin role and may step down afterwards.
+	workspace, err = wu.workspaceRepo.TransferOwnership(ctx, input.WorkspaceID, input.RequesterID, input.NewOwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TransferOwnershipOutput{
+		Workspace: dto.WorkspaceToDTO(workspace),
+	}, nil
+}
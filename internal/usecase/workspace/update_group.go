@@ -0,0 +1,45 @@
+package workspace
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"fmt"
+	"strings"
+)
+
+func (wu *WorkspaceUseCaseImpl) UpdateGroup(ctx context.Context, input UpdateGroupInput) (*GroupOutput, error) {
+	if err := validator.Struct(input); err != nil {
+		return nil, fmt.Errorf("update group validation failed: %w", err)
+	}
+
+	atLeastOne := validator.AtLeastOneProvided(input.Name) || input.DescriptionPresent
+	if !atLeastOne {
+		return nil, domain.ErrAtLeastOneProvided
+	}
+
+	if err := wu.requireAdmin(ctx, input.WorkspaceID, input.RequesterID); err != nil {
+		return nil, err
+	}
+
+	group, err := wu.groupRepo.GetByID(ctx, input.GroupID, input.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Name != nil {
+		group.Name = strings.TrimSpace(*input.Name)
+	}
+	if input.DescriptionPresent {
+		group.Description = trimmedOrNil(input.Description)
+	}
+	if err := validator.Struct(group); err != nil {
+		return nil, fmt.Errorf("update group validation failed: %w", err)
+	}
+
+	if err := wu.groupRepo.Update(ctx, group); err != nil {
+		return nil, err
+	}
+
+	return wu.groupDetail(ctx, group)
+}
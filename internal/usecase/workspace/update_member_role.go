@@ -0,0 +1,55 @@
+package workspace
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/dto"
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"errors"
+	"fmt"
+)
+
+func (wu *WorkspaceUseCaseImpl) UpdateMemberRole(ctx context.Context, input UpdateMemberRoleInput) (*UpdateMemberRoleOutput, error) {
+	if err := validator.Struct(input); err != nil {
+		return nil, fmt.Errorf("update member role validation failed: %w", err)
+	}
+
+	requesterMember, err := wu.workspaceMemberRepo.GetByWorkspaceAndUser(ctx, input.WorkspaceID, input.RequesterID)
+	if err != nil || requesterMember == nil || !requesterMember.IsAdmin() {
+		return nil, domain.ErrNotWorkspaceAdmin
+	}
+
+	workspace, err := wu.workspaceRepo.GetByID(ctx, input.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	targetMember, err := wu.workspaceMemberRepo.GetByWorkspaceAndUser(ctx, input.WorkspaceID, input.UserID)
+	if err != nil {
+		if errors.Is(err, domain.ErrMemberNotFound) {
+			return nil, domain.ErrMemberNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch member: %w", err)
+	}
+
+	if targetMember.Role != input.Role {
+		if workspace.OwnerID == input.UserID {
+			return nil, domain.ErrCannotDemoteOwner
+		}
+
+		// The repository re-checks that another admin remains.
+		targetMember, err = wu.workspaceMemberRepo.UpdateRole(ctx, input.WorkspaceID, input.UserID, input.Role)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	user, err := wu.userRepo.GetById(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch member details: %w", err)
+	}
+
+	return &UpdateMemberRoleOutput{
+		Member: dto.WorkspaceMemberToDTO(targetMember, user),
+	}, nil
+}
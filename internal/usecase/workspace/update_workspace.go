@@ -0,0 +1,51 @@
+package workspace
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/dto"
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"fmt"
+	"strings"
+)
+
+func (wu *WorkspaceUseCaseImpl) UpdateWorkspace(ctx context.Context, input UpdateWorkspaceInput) (*UpdateWorkspaceOutput, error) {
+	if err := validator.Struct(input); err != nil {
+		return nil, fmt.Errorf("update workspace validation failed: %w", err)
+	}
+
+	atLeastOne := validator.AtLeastOneProvided(input.Name) || input.DescriptionPresent
+	if !atLeastOne {
+		return nil, domain.ErrAtLeastOneProvided
+	}
+
+	requesterMember, err := wu.workspaceMemberRepo.GetByWorkspaceAndUser(ctx, input.WorkspaceID, input.RequesterID)
+	if err != nil || requesterMember == nil || !requesterMember.IsAdmin() {
+		return nil, domain.ErrNotWorkspaceAdmin
+	}
+
+	workspace, err := wu.workspaceRepo.GetByID(ctx, input.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Name != nil {
+		workspace.Name = *input.Name
+	}
+	if input.DescriptionPresent {
+		if input.Description == nil || strings.TrimSpace(*input.Description) == "" {
+			workspace.Description = nil
+		} else {
+			s := strings.TrimSpace(*input.Description)
+			workspace.Description = &s
+		}
+	}
+
+	if err := wu.workspaceRepo.Update(ctx, workspace); err != nil {
+		return nil, err
+	}
+
+	return &UpdateWorkspaceOutput{
+		Workspace: dto.WorkspaceToDTO(workspace),
+	}, nil
+}
@@ -0,0 +1,101 @@
+package workspace
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/domain/entity"
+	"collabotask/internal/dto"
+	"collabotask/internal/infrastructure/domainverify"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+func (wu *WorkspaceUseCaseImpl) requireAdmin(ctx context.Context, workspaceID, userID uuid.UUID) error {
+	member, err := wu.workspaceMemberRepo.GetByWorkspaceAndUser(ctx, workspaceID, userID)
+	if err != nil || member == nil || !member.IsAdmin() {
+		return domain.ErrNotWorkspaceAdmin
+	}
+	return nil
+}
+
+// requireDirectoryAccess lets any member but a guest see other members.
+func (wu *WorkspaceUseCaseImpl) requireDirectoryAccess(ctx context.Context, workspaceID, userID uuid.UUID) error {
+	member, err := wu.workspaceMemberRepo.GetByWorkspaceAndUser(ctx, workspaceID, userID)
+	if err != nil || member == nil || member.IsEmpty() {
+		return domain.ErrUserNotInWorkspace
+	}
+	if member.IsGuest() {
+		return domain.ErrWorkspaceGuest
+	}
+	return nil
+}
+
+func (wu *WorkspaceUseCaseImpl) groupDetail(ctx context.Context, group *entity.WorkspaceGroup) (*GroupOutput, error) {
+	members, err := wu.groupRepo.ListMembers(ctx, group.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch group members: %w", err)
+	}
+
+	userIDs := make([]uuid.UUID, 0, len(members))
+	for _, member := range members {
+		userIDs = append(userIDs, member.UserID)
+	}
+
+	users, err := wu.userRepo.GetByIds(ctx, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch member details: %w", err)
+	}
+
+	groupMembers := make([]dto.WorkspaceGroupMemberDTO, 0, len(members))
+	for _, member := range members {
+		user, ok := users[member.UserID]
+		if !ok || user == nil {
+			continue
+		}
+		groupMembers = append(groupMembers, dto.WorkspaceGroupMemberToDTO(member, user))
+	}
+
+	return &GroupOutput{
+		Group: dto.WorkspaceGroupDetailDTO{
+			WorkspaceGroupDTO: dto.WorkspaceGroupToDTO(group, uint(len(groupMembers))),
+			Members:           groupMembers,
+		},
+	}, nil
+}
+
+func emailDomainToDTO(d *entity.WorkspaceEmailDomain) dto.WorkspaceEmailDomainDTO {
+	return dto.WorkspaceEmailDomainDTO{
+		ID:              d.ID,
+		Domain:          d.Domain,
+		Verified:        d.IsVerified(),
+		VerifiedAt:      d.VerifiedAt,
+		RecordName:      domainverify.RecordName(d.Domain),
+		RecordValue:     domainverify.RecordValue(d.VerificationToken),
+		DefaultBoardIDs: d.DefaultBoardIDs,
+		CreatedAt:       d.CreatedAt,
+	}
+}
+
+func uniqueUUIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]struct{}, len(ids))
+	unique := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+	return unique
+}
+
+// trimmedOrNil treats a missing or blank optional text as unset.
+func trimmedOrNil(s *string) *string {
+	if s == nil || strings.TrimSpace(*s) == "" {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*s)
+	return &trimmed
+}
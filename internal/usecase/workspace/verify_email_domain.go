@@ -0,0 +1,44 @@
+package workspace
+
+import (
+	"collabotask/internal/domain"
+	"collabotask/internal/infrastructure/validator"
+	"context"
+	"fmt"
+	"time"
+)
+
+func (wu *WorkspaceUseCaseImpl) VerifyEmailDomain(ctx context.Context, input EmailDomainInput) (*EmailDomainOutput, error) {
+	if err := validator.Struct(input); err != nil {
+		return nil, fmt.Errorf("verify email domain validation failed: %w", err)
+	}
+
+	if err := wu.requireAdmin(ctx, input.WorkspaceID, input.RequesterID); err != nil {
+		return nil, err
+	}
+
+	emailDomain, err := wu.emailDomainRepo.GetByID(ctx, input.DomainID, input.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !emailDomain.IsVerified() {
+		ok, err := wu.domainVerifier.Verify(ctx, emailDomain.Domain, emailDomain.VerificationToken)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, domain.ErrEmailDomainVerificationFailed
+		}
+
+		verifiedAt := time.Now().UTC()
+		if err := wu.emailDomainRepo.MarkVerified(ctx, emailDomain.ID, emailDomain.WorkspaceID, verifiedAt); err != nil {
+			return nil, err
+		}
+		emailDomain.VerifiedAt = &verifiedAt
+	}
+
+	return &EmailDomainOutput{
+		EmailDomain: emailDomainToDTO(emailDomain),
+	}, nil
+}
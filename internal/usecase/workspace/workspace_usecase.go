@@ -1,23 +1,51 @@
 package workspace
 
 import (
+	"collabotask/internal/config"
 	"collabotask/internal/domain/repository"
+	"collabotask/internal/infrastructure/domainverify"
+	"collabotask/internal/infrastructure/mailer"
+	"collabotask/internal/usecase/common"
 )
 
 type WorkspaceUseCaseImpl struct {
 	workspaceRepo       repository.WorkspaceRepository
 	workspaceMemberRepo repository.WorkspaceMemberRepository
 	userRepo            repository.UserRepository
+	invitationRepo      repository.WorkspaceInvitationRepository
+	joinLinkRepo        repository.WorkspaceJoinLinkRepository
+	emailDomainRepo     repository.WorkspaceEmailDomainRepository
+	groupRepo           repository.WorkspaceGroupRepository
+	invitationAcceptor  common.WorkspaceInvitationAcceptor
+	mailer              mailer.Mailer
+	domainVerifier      *domainverify.Verifier
+	authCfg             *config.AuthConfig
 }
 
 func NewWorkspaceUseCase(
 	wRepo repository.WorkspaceRepository,
 	wmRepo repository.WorkspaceMemberRepository,
 	uRepo repository.UserRepository,
+	wiRepo repository.WorkspaceInvitationRepository,
+	jlRepo repository.WorkspaceJoinLinkRepository,
+	edRepo repository.WorkspaceEmailDomainRepository,
+	gRepo repository.WorkspaceGroupRepository,
+	invitationAcceptor common.WorkspaceInvitationAcceptor,
+	mailer mailer.Mailer,
+	domainVerifier *domainverify.Verifier,
+	authCfg *config.AuthConfig,
 ) WorkspaceUseCase {
 	return &WorkspaceUseCaseImpl{
 		workspaceRepo:       wRepo,
 		workspaceMemberRepo: wmRepo,
 		userRepo:            uRepo,
+		invitationRepo:      wiRepo,
+		joinLinkRepo:        jlRepo,
+		emailDomainRepo:     edRepo,
+		groupRepo:           gRepo,
+		invitationAcceptor:  invitationAcceptor,
+		mailer:              mailer,
+		domainVerifier:      domainVerifier,
+		authCfg:             authCfg,
 	}
 }
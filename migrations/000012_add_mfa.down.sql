@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_mfa_challenges_user_id;
+DROP INDEX IF EXISTS idx_mfa_recovery_codes_user_id;
+DROP TABLE IF EXISTS mfa_challenges;
+DROP TABLE IF EXISTS mfa_recovery_codes;
+DROP TABLE IF EXISTS user_mfa;
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS workspace_invitations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    workspace_id UUID NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    email VARCHAR(255) NOT NULL,
+    role VARCHAR(50) NOT NULL DEFAULT 'MEMBER' CHECK (role IN ('ADMIN', 'MEMBER')),
+    token_hash VARCHAR(64) UNIQUE NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'ACCEPTED', 'REVOKED')),
+    invited_by UUID NULL REFERENCES users(id) ON DELETE SET NULL,
+    accepted_by UUID NULL REFERENCES users(id) ON DELETE SET NULL,
+    expires_at TIMESTAMP NOT NULL,
+    accepted_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Re-inviting an address refreshes its pending invitation instead of adding another.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_workspace_invitations_pending
+    ON workspace_invitations(workspace_id, email) WHERE status = 'PENDING';
+CREATE INDEX IF NOT EXISTS idx_workspace_invitations_email ON workspace_invitations(email);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_workspace_join_links_workspace_id;
+DROP TABLE IF EXISTS workspace_join_links;
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS workspace_join_links (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    workspace_id UUID NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    code_prefix VARCHAR(16) NOT NULL,
+    code_hash VARCHAR(64) UNIQUE NOT NULL,
+    role VARCHAR(50) NOT NULL DEFAULT 'MEMBER' CHECK (role IN ('ADMIN', 'MEMBER')),
+    max_uses INTEGER NULL CHECK (max_uses > 0),
+    use_count INTEGER NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP NULL,
+    created_by UUID NULL REFERENCES users(id) ON DELETE SET NULL,
+    revoked_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_workspace_join_links_workspace_id ON workspace_join_links(workspace_id);
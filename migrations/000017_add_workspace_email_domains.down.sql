@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS workspace_domain_auto_joins;
+DROP TABLE IF EXISTS workspace_email_domain_boards;
+DROP INDEX IF EXISTS idx_workspace_email_domains_verified;
+DROP TABLE IF EXISTS workspace_email_domains;
@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS workspace_email_domains (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    workspace_id UUID NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    domain VARCHAR(253) NOT NULL,
+    verification_token VARCHAR(64) NOT NULL,
+    created_by UUID NULL REFERENCES users(id) ON DELETE SET NULL,
+    verified_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (workspace_id, domain)
+);
+
+-- Sign-ins look up verified domains by the user's email domain.
+CREATE INDEX IF NOT EXISTS idx_workspace_email_domains_verified
+    ON workspace_email_domains(domain) WHERE verified_at IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS workspace_email_domain_boards (
+    domain_id UUID NOT NULL REFERENCES workspace_email_domains(id) ON DELETE CASCADE,
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    PRIMARY KEY (domain_id, board_id)
+);
+
+-- Each user is joined by domain at most once per workspace, so leaving or
+-- being removed sticks across later logins.
+CREATE TABLE IF NOT EXISTS workspace_domain_auto_joins (
+    workspace_id UUID NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    joined_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (workspace_id, user_id)
+);
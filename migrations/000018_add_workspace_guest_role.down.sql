@@ -0,0 +1,18 @@
+-- Guests are removed rather than promoted so rolling back never widens access.
+DELETE FROM workspace_join_links WHERE role = 'GUEST';
+ALTER TABLE workspace_join_links DROP CONSTRAINT IF EXISTS workspace_join_links_role_check;
+ALTER TABLE workspace_join_links ADD CONSTRAINT workspace_join_links_role_check CHECK (role IN ('ADMIN', 'MEMBER'));
+
+DELETE FROM workspace_invitations WHERE role = 'GUEST';
+ALTER TABLE workspace_invitations DROP CONSTRAINT IF EXISTS workspace_invitations_role_check;
+ALTER TABLE workspace_invitations ADD CONSTRAINT workspace_invitations_role_check CHECK (role IN ('ADMIN', 'MEMBER'));
+
+DELETE FROM board_members bm
+USING boards b, workspace_members wm
+WHERE bm.board_id = b.id
+    AND wm.workspace_id = b.workspace_id
+    AND wm.user_id = bm.user_id
+    AND wm.role = 'GUEST';
+DELETE FROM workspace_members WHERE role = 'GUEST';
+ALTER TABLE workspace_members DROP CONSTRAINT IF EXISTS workspace_members_role_check;
+ALTER TABLE workspace_members ADD CONSTRAINT workspace_members_role_check CHECK (role IN ('ADMIN', 'MEMBER'));
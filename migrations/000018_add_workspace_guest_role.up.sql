@@ -0,0 +1,8 @@
+ALTER TABLE workspace_members DROP CONSTRAINT IF EXISTS workspace_members_role_check;
+ALTER TABLE workspace_members ADD CONSTRAINT workspace_members_role_check CHECK (role IN ('ADMIN', 'MEMBER', 'GUEST'));
+
+ALTER TABLE workspace_invitations DROP CONSTRAINT IF EXISTS workspace_invitations_role_check;
+ALTER TABLE workspace_invitations ADD CONSTRAINT workspace_invitations_role_check CHECK (role IN ('ADMIN', 'MEMBER', 'GUEST'));
+
+ALTER TABLE workspace_join_links DROP CONSTRAINT IF EXISTS workspace_join_links_role_check;
+ALTER TABLE workspace_join_links ADD CONSTRAINT workspace_join_links_role_check CHECK (role IN ('ADMIN', 'MEMBER', 'GUEST'));
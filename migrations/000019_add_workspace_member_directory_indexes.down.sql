@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_users_lower_email_prefix;
+DROP INDEX IF EXISTS idx_users_lower_name_prefix;
+DROP INDEX IF EXISTS idx_workspace_members_workspace_role;
+DROP INDEX IF EXISTS idx_workspace_members_workspace_joined_at;
@@ -0,0 +1,4 @@
+CREATE INDEX IF NOT EXISTS idx_workspace_members_workspace_joined_at ON workspace_members(workspace_id, joined_at, user_id);
+CREATE INDEX IF NOT EXISTS idx_workspace_members_workspace_role ON workspace_members(workspace_id, role);
+CREATE INDEX IF NOT EXISTS idx_users_lower_name_prefix ON users(lower(name) text_pattern_ops);
+CREATE INDEX IF NOT EXISTS idx_users_lower_email_prefix ON users(lower(email) text_pattern_ops);
@@ -0,0 +1,7 @@
+ALTER TABLE board_members DROP COLUMN IF EXISTS added_via_group;
+DROP INDEX IF EXISTS idx_board_group_links_group_id;
+DROP TABLE IF EXISTS board_group_links;
+DROP INDEX IF EXISTS idx_workspace_group_members_workspace_user;
+DROP TABLE IF EXISTS workspace_group_members;
+DROP INDEX IF EXISTS idx_workspace_groups_workspace_name;
+DROP TABLE IF EXISTS workspace_groups;
@@ -0,0 +1,42 @@
+CREATE TABLE IF NOT EXISTS workspace_groups (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    workspace_id UUID NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    description TEXT NULL,
+    created_by UUID NULL REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_workspace_groups_workspace_name
+    ON workspace_groups(workspace_id, lower(name));
+
+-- Group members must be workspace members; leaving or being removed from the
+-- workspace drops them from its groups.
+CREATE TABLE IF NOT EXISTS workspace_group_members (
+    group_id UUID NOT NULL REFERENCES workspace_groups(id) ON DELETE CASCADE,
+    workspace_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    added_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (group_id, user_id),
+    FOREIGN KEY (workspace_id, user_id) REFERENCES workspace_members(workspace_id, user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_workspace_group_members_workspace_user
+    ON workspace_group_members(workspace_id, user_id);
+
+-- Boards whose membership follows a group: joining the group adds the user
+-- to the board and leaving it removes what the group granted.
+CREATE TABLE IF NOT EXISTS board_group_links (
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    group_id UUID NOT NULL REFERENCES workspace_groups(id) ON DELETE CASCADE,
+    linked_by UUID NULL REFERENCES users(id) ON DELETE SET NULL,
+    linked_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (board_id, group_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_board_group_links_group_id ON board_group_links(group_id);
+
+-- Marks board memberships granted through a synced group, so only those are
+-- removed when the user leaves the group.
+ALTER TABLE board_members ADD COLUMN IF NOT EXISTS added_via_group BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,6 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS email_verified_backfilled BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- 000008 marked every existing account verified at its creation time without
+-- the address ever being proven. Those accounts keep logging in, but do not
+-- count as verified for granting workspace access until they verify for real.
+UPDATE users SET email_verified_backfilled = TRUE WHERE email_verified_at = created_at;